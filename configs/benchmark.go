@@ -1,43 +1,135 @@
 package configs
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
 	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
 )
 
+// pubKeyHexLength is the length of a 48 bytes BLS public key encoded as a 0x prefixed hex string
+const pubKeyHexLength = 98
+
 type Metric struct {
 	Enabled bool `mapstructure:"enabled"`
 }
 
 type ConsensusMetrics struct {
-	Client      Metric `mapstructure:"client"`
-	Latency     Metric `mapstructure:"latency"`
-	Peers       Metric `mapstructure:"peers"`
-	Attestation Metric `mapstructure:"attestation"`
+	Client       Metric `mapstructure:"client"`
+	Latency      Metric `mapstructure:"latency"`
+	Peers        Metric `mapstructure:"peers"`
+	Attestation  Metric `mapstructure:"attestation"`
+	Agreement    Metric `mapstructure:"agreement"`
+	Health       Metric `mapstructure:"health"`
+	Performance  Metric `mapstructure:"performance"`
+	Inclusion    Metric `mapstructure:"inclusion"`
+	Proposal     Metric `mapstructure:"proposal"`
+	Slashing     Metric `mapstructure:"slashing"`
+	Network      Metric `mapstructure:"network"`
+	Reachability Metric `mapstructure:"reachability"`
 }
 
 type ExecutionMetrics struct {
-	Peers   Metric `mapstructure:"peers"`
-	Latency Metric `mapstructure:"latency"`
+	Client      Metric `mapstructure:"client"`
+	Peers       Metric `mapstructure:"peers"`
+	Latency     Metric `mapstructure:"latency"`
+	Network     Metric `mapstructure:"network"`
+	Sync        Metric `mapstructure:"sync"`
+	RPC         Metric `mapstructure:"rpc"`
+	WebSocket   Metric `mapstructure:"websocket"`
+	Engine      Metric `mapstructure:"engine"`
+	Consistency Metric `mapstructure:"consistency"`
 }
 
 type SSVMetrics struct {
-	Peers       Metric `mapstructure:"peers"`
-	Connections Metric `mapstructure:"connections"`
+	Client       Metric `mapstructure:"client"`
+	Health       Metric `mapstructure:"health"`
+	Peers        Metric `mapstructure:"peers"`
+	Connections  Metric `mapstructure:"connections"`
+	Subnets      Metric `mapstructure:"subnets"`
+	Scrape       Metric `mapstructure:"scrape"`
+	EventSyncer  Metric `mapstructure:"event-syncer"`
+	Reachability Metric `mapstructure:"reachability"`
+	Profiling    Metric `mapstructure:"profiling"`
 }
 
 type InfrastructureMetrics struct {
-	CPU    Metric `mapstructure:"cpu"`
-	Memory Metric `mapstructure:"memory"`
+	CPU         Metric `mapstructure:"cpu"`
+	Memory      Metric `mapstructure:"memory"`
+	Disk        Metric `mapstructure:"disk"`
+	DiskLatency Metric `mapstructure:"disk-latency"`
+	Network     Metric `mapstructure:"network"`
+	Process     Metric `mapstructure:"process"`
 }
 
 type Consensus struct {
-	Addresses []string         `mapstructure:"address"`
-	Metrics   ConsensusMetrics `mapstructure:"metrics"`
+	Addresses []string `mapstructure:"address"`
+	// Validators holds indices or public keys of the validators run by the SSV cluster
+	Validators []string         `mapstructure:"validators"`
+	P2P        P2P              `mapstructure:"p2p"`
+	Metrics    ConsensusMetrics `mapstructure:"metrics"`
+}
+
+// P2P configures the ports probed by the reachability metrics
+type P2P struct {
+	// Hosts are probed instead of the hosts of the node addresses, listed in the same order as the addresses
+	Hosts []string `mapstructure:"host"`
+	// TCPPort and UDPPort are not probed when set to 0
+	TCPPort uint16 `mapstructure:"tcp-port"`
+	UDPPort uint16 `mapstructure:"udp-port"`
+}
+
+// Host returns the host to probe for the i-th node address, falling back to the host of the address itself
+func (p P2P) Host(i int, addr string) (string, error) {
+	if i < len(p.Hosts) && p.Hosts[i] != "" {
+		return p.Hosts[i], nil
+	}
+	parsedURL, err := url.Parse(addr)
+	if err != nil {
+		return "", errors.Join(err, errors.New("error parsing address to URL type"))
+	}
+	return parsedURL.Hostname(), nil
+}
+
+func (p P2P) validate(addressesCount int) error {
+	if len(p.Hosts) != 0 && len(p.Hosts) != addressesCount {
+		return fmt.Errorf("P2P hosts count: %d did not match the addresses count: %d", len(p.Hosts), addressesCount)
+	}
+	return nil
+}
+
+// ValidatorIDs splits the configured validators into validator indices and public keys
+func (c Consensus) ValidatorIDs() (indices []uint64, pubKeys []string, err error) {
+	for _, validator := range c.Validators {
+		validator = strings.TrimSpace(validator)
+		if validator == "" {
+			continue
+		}
+		if strings.HasPrefix(validator, "0x") {
+			if len(validator) != pubKeyHexLength {
+				return nil, nil, fmt.Errorf("validator public key: '%s' was not a valid BLS public key", validator)
+			}
+			if _, err := hex.DecodeString(validator[2:]); err != nil {
+				return nil, nil, errors.Join(err, fmt.Errorf("validator public key: '%s' was not a valid hex string", validator))
+			}
+			pubKeys = append(pubKeys, strings.ToLower(validator))
+			continue
+		}
+		index, err := strconv.ParseUint(validator, 10, 64)
+		if err != nil {
+			return nil, nil, errors.Join(err, fmt.Errorf("validator: '%s' was neither a validator index nor a public key", validator))
+		}
+		indices = append(indices, index)
+	}
+
+	return indices, pubKeys, nil
 }
 
 func (c Consensus) AddrURLs() ([]*url.URL, error) {
@@ -55,9 +147,27 @@ func (c Consensus) AddrURLs() ([]*url.URL, error) {
 
 type Execution struct {
 	Addresses []string         `mapstructure:"address"`
+	Engine    Engine           `mapstructure:"engine"`
+	RPC       RPC              `mapstructure:"rpc"`
 	Metrics   ExecutionMetrics `mapstructure:"metrics"`
 }
 
+// RPC configures the JSON-RPC calls timed by the execution client RPC metric
+type RPC struct {
+	// Methods to time, all supported methods are timed when empty
+	Methods []string `mapstructure:"methods"`
+	// LogsRange is the number of most recent blocks queried by 'eth_getLogs'
+	LogsRange uint64        `mapstructure:"logs-range"`
+	Timeout   time.Duration `mapstructure:"timeout"`
+}
+
+// Engine configures the authenticated Engine API endpoints checked by the execution client engine metric
+type Engine struct {
+	Addresses []string `mapstructure:"address"`
+	// JWTSecretFile is a path to the hex encoded secret shared by the consensus and execution clients
+	JWTSecretFile string `mapstructure:"jwt-secret-file"`
+}
+
 func (e Execution) AddrURLs() ([]*url.URL, error) {
 	var urls []*url.URL
 	for _, addr := range e.Addresses {
@@ -72,20 +182,183 @@ func (e Execution) AddrURLs() ([]*url.URL, error) {
 }
 
 type SSV struct {
-	Address string     `mapstructure:"address"`
-	Metrics SSVMetrics `mapstructure:"metrics"`
+	Addresses []string `mapstructure:"address"`
+	// MetricsAddresses are the Prometheus endpoints of the SSV nodes, listed in the same order as the addresses
+	MetricsAddresses []string `mapstructure:"metrics-address"`
+	// Names are optional human-readable names of the SSV nodes, listed in the same order as the addresses
+	Names     []string   `mapstructure:"names"`
+	Scrape    Scrape     `mapstructure:"scrape"`
+	P2P       P2P        `mapstructure:"p2p"`
+	Profiling Profiling  `mapstructure:"profiling"`
+	Metrics   SSVMetrics `mapstructure:"metrics"`
 }
 
-func (s SSV) AddrURL() (*url.URL, error) {
-	parsedURL, err := url.Parse(s.Address)
-	if err != nil {
-		return nil, errors.Join(err, errors.New("error parsing SSV address to URL type"))
+// Profiling configures the capture of pprof profiles of the SSV nodes when their metrics reach High severity
+type Profiling struct {
+	// Addresses of the pprof endpoints of the SSV nodes, listed in the same order as the addresses. Defaults to the metrics addresses
+	Addresses []string `mapstructure:"address"`
+	// Metrics are the names of the SSV metrics triggering a capture, e.g. `Health`
+	Metrics         []string      `mapstructure:"metrics"`
+	OutputDirectory string        `mapstructure:"output-directory"`
+	CPUDuration     time.Duration `mapstructure:"cpu-duration"`
+	// Cooldown is the minimum time between two captures of the same node
+	Cooldown time.Duration `mapstructure:"cooldown"`
+}
+
+// Scrape configures the measurements derived from the Prometheus metrics of the SSV nodes
+type Scrape struct {
+	Measurements []ScrapeMeasurement `mapstructure:"measurements"`
+	// LastProcessedBlock selects the last block processed by the event syncer of the SSV node
+	LastProcessedBlock string `mapstructure:"last-processed-block"`
+}
+
+type ScrapeMeasurement struct {
+	Name string `mapstructure:"name"`
+	// Type is either 'value', 'increase' or 'ratio'
+	Type string `mapstructure:"type"`
+	// Selector is a PromQL instant vector selector, e.g. `ssv_validator_roles_failed{role="ATTESTER"}`
+	Selector string `mapstructure:"selector"`
+	// Total is the selector of the denominator of 'ratio' measurements
+	Total      string      `mapstructure:"total"`
+	Conditions []Condition `mapstructure:"conditions"`
+}
+
+type Condition struct {
+	Threshold float64 `mapstructure:"threshold"`
+	Operator  string  `mapstructure:"operator"`
+	Severity  string  `mapstructure:"severity"`
+}
+
+// defaultDiskPath is monitored by the disk metric when no paths are configured. The disk latency metric writes to the
+// paths, so it requires them to be configured explicitly.
+const defaultDiskPath = "/"
+
+const (
+	defaultProfilingCPUDuration = 10 * time.Second
+	defaultProfilingCooldown    = 15 * time.Minute
+)
+
+// defaultLastProcessedBlock is used when no last processed block selector is configured
+const defaultLastProcessedBlock = "ssv_event_syncer_last_processed_block"
+
+// defaultScrapeMeasurements are used when no scrape measurements are configured
+var defaultScrapeMeasurements = []ScrapeMeasurement{
+	{
+		Name:     "DutySuccessRatio",
+		Type:     "ratio",
+		Selector: "ssv_validator_roles_submitted",
+		Total:    `{__name__=~"ssv_validator_roles_(submitted|failed)"}`,
+		Conditions: []Condition{
+			{Threshold: 90, Operator: string(metric.OperatorLessThan), Severity: string(metric.SeverityHigh)},
+			{Threshold: 98, Operator: string(metric.OperatorLessThan), Severity: string(metric.SeverityMedium)},
+		},
+	},
+	{
+		Name:     "ValidationRejections",
+		Type:     "increase",
+		Selector: `ssv_message_validation{status="rejected"}`,
+		Conditions: []Condition{
+			{Threshold: 100, Operator: string(metric.OperatorGreaterThanOrEqual), Severity: string(metric.SeverityLow)},
+		},
+	},
+}
+
+func (s Scrape) validate() error {
+	var names []string
+	for _, measurement := range s.Measurements {
+		if measurement.Name == "" {
+			return errors.New("scrape measurement name was empty")
+		}
+		if slices.Contains(names, measurement.Name) {
+			return fmt.Errorf("scrape measurement name: '%s' was not unique", measurement.Name)
+		}
+		names = append(names, measurement.Name)
+
+		if measurement.Selector == "" {
+			return fmt.Errorf("scrape measurement: '%s' selector was empty", measurement.Name)
+		}
+		for _, condition := range measurement.Conditions {
+			if !slices.Contains([]metric.Operator{metric.OperatorGreaterThan, metric.OperatorLessThan, metric.OperatorGreaterThanOrEqual, metric.OperatorLessThanOrEqual, metric.OperatorEqual}, metric.Operator(condition.Operator)) {
+				return fmt.Errorf("scrape measurement: '%s' condition operator: '%s' is not supported", measurement.Name, condition.Operator)
+			}
+			if !slices.Contains([]metric.SeverityLevel{metric.SeverityLow, metric.SeverityMedium, metric.SeverityHigh}, metric.SeverityLevel(condition.Severity)) {
+				return fmt.Errorf("scrape measurement: '%s' condition severity: '%s' is not supported", measurement.Name, condition.Severity)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s SSV) AddrURLs() ([]*url.URL, error) {
+	var urls []*url.URL
+	for _, addr := range s.Addresses {
+		parsedURL, err := url.Parse(addr)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("error parsing SSV address to URL type"))
+		}
+		urls = append(urls, parsedURL)
+	}
+
+	return urls, nil
+}
+
+type Fork struct {
+	Name    string `mapstructure:"name"`
+	Epoch   uint64 `mapstructure:"epoch"`
+	Version string `mapstructure:"version"`
+}
+
+// Network describes a network that is not part of the supported networks list, e.g. a devnet or a local testnet.
+// Parameters left empty are fetched from the consensus client on startup.
+type Network struct {
+	Name            string `mapstructure:"name"`
+	ChainID         uint64 `mapstructure:"chain-id"`
+	DepositContract string `mapstructure:"deposit-contract"`
+	// SSVNetworkContract is the address of the SSV network contract deployed on the network
+	SSVNetworkContract string `mapstructure:"ssv-network-contract"`
+	GenesisTime        int64  `mapstructure:"genesis-time"`
+	SecondsPerSlot     uint64 `mapstructure:"seconds-per-slot"`
+	SlotsPerEpoch      uint64 `mapstructure:"slots-per-epoch"`
+	Forks              []Fork `mapstructure:"forks"`
+}
+
+func (n Network) toNetwork() network.Network {
+	var genesisTime time.Time
+	if n.GenesisTime != 0 {
+		genesisTime = time.Unix(n.GenesisTime, 0)
+	}
+
+	var forks []network.Fork
+	for _, fork := range n.Forks {
+		forks = append(forks, network.Fork{
+			Name:    strings.ToLower(fork.Name),
+			Epoch:   fork.Epoch,
+			Version: fork.Version,
+		})
+	}
+
+	return network.Network{
+		Name:               network.Name(strings.ToLower(n.Name)),
+		ChainID:            n.ChainID,
+		DepositContract:    n.DepositContract,
+		SSVNetworkContract: n.SSVNetworkContract,
+		GenesisTime:        genesisTime,
+		SecondsPerSlot:     n.SecondsPerSlot,
+		SlotsPerEpoch:      n.SlotsPerEpoch,
+		Forks:              forks,
 	}
-	return parsedURL, nil
 }
 
 type Infrastructure struct {
-	Metrics InfrastructureMetrics `mapstructure:"metrics"`
+	// DiskPaths are monitored by the disk metrics, e.g. the data directories of the SSV, consensus and execution clients
+	DiskPaths []string `mapstructure:"disk-paths"`
+	// NetworkInterfaces are monitored by the network metric, the physical interfaces of the host when empty
+	NetworkInterfaces []string `mapstructure:"network-interfaces"`
+	// Processes are monitored by the process metric, in the '[label=]kind:value' format where kind is one of 'pid', 'name' or 'cgroup',
+	// e.g. 'ssv=cgroup:/system.slice/ssv.service'
+	Processes []string              `mapstructure:"processes"`
+	Metrics   InfrastructureMetrics `mapstructure:"metrics"`
 }
 
 type Server struct {
@@ -100,19 +373,47 @@ type Benchmark struct {
 	Server         Server         `mapstructure:"server"`
 	Duration       time.Duration  `mapstructure:"duration"`
 	Network        string         `mapstructure:"network"`
+	CustomNetworks []Network      `mapstructure:"custom-networks"`
+	// VersionPolicyFile is a path to the file with minimum and blocked client versions per client and network
+	VersionPolicyFile string `mapstructure:"version-policy-file"`
+}
+
+// NetworkParams returns the parameters of the configured network. Custom networks take precedence over the supported ones.
+func (b Benchmark) NetworkParams() (network.Network, error) {
+	name := network.Name(strings.ToLower(b.Network))
+
+	for _, custom := range b.CustomNetworks {
+		if network.Name(strings.ToLower(custom.Name)) == name {
+			return custom.toNetwork(), nil
+		}
+	}
+
+	if err := name.Validate(); err != nil {
+		return network.Network{}, err
+	}
+
+	return network.Supported[name], nil
 }
 
 func (b *Benchmark) Validate() (bool, error) {
 	if b.Consensus.Metrics.Peers.Enabled ||
 		b.Consensus.Metrics.Attestation.Enabled ||
 		b.Consensus.Metrics.Client.Enabled ||
-		b.Consensus.Metrics.Latency.Enabled {
+		b.Consensus.Metrics.Latency.Enabled ||
+		b.Consensus.Metrics.Agreement.Enabled ||
+		b.Consensus.Metrics.Health.Enabled ||
+		b.Consensus.Metrics.Performance.Enabled ||
+		b.Consensus.Metrics.Inclusion.Enabled ||
+		b.Consensus.Metrics.Proposal.Enabled ||
+		b.Consensus.Metrics.Slashing.Enabled ||
+		b.Consensus.Metrics.Network.Enabled ||
+		b.Consensus.Metrics.Reachability.Enabled {
 		var urls []string
 		for _, addrString := range b.Consensus.Addresses {
 			//configuration supports both yaml arrays and multi address strings with semicolon as separator
 			addresses := strings.Split(addrString, ";")
 			for _, addr := range addresses {
-				url, err := sanitizeURL(addr)
+				url, err := sanitizeURL(addr, httpSchemes)
 				if err != nil {
 					return false, errors.Join(err, errors.New("consensus client address was not a valid URL"))
 				}
@@ -120,16 +421,22 @@ func (b *Benchmark) Validate() (bool, error) {
 			}
 		}
 
+		if b.Consensus.Metrics.Reachability.Enabled {
+			if err := b.Consensus.P2P.validate(len(urls)); err != nil {
+				return false, errors.Join(err, errors.New("consensus client P2P configuration was not valid"))
+			}
+		}
+
 		b.Consensus.Addresses = urls
 	}
 
-	if b.Execution.Metrics.Peers.Enabled || b.Execution.Metrics.Latency.Enabled {
+	if b.Execution.Metrics.Client.Enabled || b.Execution.Metrics.Peers.Enabled || b.Execution.Metrics.Latency.Enabled || b.Execution.Metrics.Network.Enabled || b.Execution.Metrics.Sync.Enabled || b.Execution.Metrics.RPC.Enabled || b.Execution.Metrics.WebSocket.Enabled || b.Execution.Metrics.Consistency.Enabled || b.SSV.Metrics.EventSyncer.Enabled {
 		var urls []string
 		for _, addrString := range b.Execution.Addresses {
 			//configuration supports both yaml arrays and multi address strings with semicolon as separator
 			addresses := strings.Split(addrString, ";")
 			for _, addr := range addresses {
-				url, err := sanitizeURL(addr)
+				url, err := sanitizeURL(addr, executionSchemes)
 				if err != nil {
 					return false, errors.Join(err, errors.New("execution client address was not a valid URL"))
 				}
@@ -140,16 +447,141 @@ func (b *Benchmark) Validate() (bool, error) {
 		b.Execution.Addresses = urls
 	}
 
-	if b.SSV.Metrics.Peers.Enabled || b.SSV.Metrics.Connections.Enabled {
-		url, err := sanitizeURL(b.SSV.Address)
-		if err != nil {
-			return false, errors.Join(err, errors.New("SSV client address was not a valid URL"))
+	if b.Execution.Metrics.Engine.Enabled && len(b.Execution.Engine.Addresses) != 0 {
+		var urls []string
+		for _, addrString := range b.Execution.Engine.Addresses {
+			//configuration supports both yaml arrays and multi address strings with semicolon as separator
+			addresses := strings.Split(addrString, ";")
+			for _, addr := range addresses {
+				url, err := sanitizeURL(addr, httpSchemes)
+				if err != nil {
+					return false, errors.Join(err, errors.New("execution engine API address was not a valid URL"))
+				}
+				urls = append(urls, url)
+			}
+		}
+		if b.Execution.Engine.JWTSecretFile == "" {
+			return false, errors.New("execution engine API JWT secret file was empty")
+		}
+
+		b.Execution.Engine.Addresses = urls
+	}
+
+	if b.SSV.Metrics.Client.Enabled || b.SSV.Metrics.Subnets.Enabled || b.SSV.Metrics.Health.Enabled || b.SSV.Metrics.Peers.Enabled || b.SSV.Metrics.Connections.Enabled || b.SSV.Metrics.Scrape.Enabled || b.SSV.Metrics.EventSyncer.Enabled || b.SSV.Metrics.Reachability.Enabled {
+		var urls []string
+		for _, addrString := range b.SSV.Addresses {
+			//configuration supports both yaml arrays and multi address strings with semicolon as separator
+			addresses := strings.Split(addrString, ";")
+			for _, addr := range addresses {
+				url, err := sanitizeURL(addr, httpSchemes)
+				if err != nil {
+					return false, errors.Join(err, errors.New("SSV client address was not a valid URL"))
+				}
+				urls = append(urls, url)
+			}
+		}
+		if len(b.SSV.Names) != 0 && len(b.SSV.Names) != len(urls) {
+			return false, fmt.Errorf("SSV client names count: %d did not match the addresses count: %d", len(b.SSV.Names), len(urls))
+		}
+		if b.SSV.Metrics.Reachability.Enabled {
+			if err := b.SSV.P2P.validate(len(urls)); err != nil {
+				return false, errors.Join(err, errors.New("SSV client P2P configuration was not valid"))
+			}
+		}
+
+		b.SSV.Addresses = urls
+	}
+
+	if (b.SSV.Metrics.Scrape.Enabled || b.SSV.Metrics.EventSyncer.Enabled) && len(b.SSV.MetricsAddresses) != 0 {
+		var urls []string
+		for _, addrString := range b.SSV.MetricsAddresses {
+			//configuration supports both yaml arrays and multi address strings with semicolon as separator
+			addresses := strings.Split(addrString, ";")
+			for _, addr := range addresses {
+				url, err := sanitizeURL(addr, httpSchemes)
+				if err != nil {
+					return false, errors.Join(err, errors.New("SSV client metrics address was not a valid URL"))
+				}
+				urls = append(urls, url)
+			}
+		}
+		if len(b.SSV.Addresses) != 0 && len(b.SSV.Addresses) != len(urls) {
+			return false, fmt.Errorf("SSV client metrics addresses count: %d did not match the addresses count: %d", len(urls), len(b.SSV.Addresses))
+		}
+
+		if len(b.SSV.Scrape.Measurements) == 0 {
+			b.SSV.Scrape.Measurements = defaultScrapeMeasurements
+		}
+		if b.SSV.Scrape.LastProcessedBlock == "" {
+			b.SSV.Scrape.LastProcessedBlock = defaultLastProcessedBlock
+		}
+		if err := b.SSV.Scrape.validate(); err != nil {
+			return false, errors.Join(err, errors.New("SSV client scrape measurements were not valid"))
+		}
+
+		b.SSV.MetricsAddresses = urls
+	}
+
+	if b.SSV.Metrics.Profiling.Enabled {
+		if len(b.SSV.Profiling.Addresses) == 0 {
+			b.SSV.Profiling.Addresses = b.SSV.MetricsAddresses
+		}
+		var urls []string
+		for _, addrString := range b.SSV.Profiling.Addresses {
+			//configuration supports both yaml arrays and multi address strings with semicolon as separator
+			addresses := strings.Split(addrString, ";")
+			for _, addr := range addresses {
+				url, err := sanitizeURL(addr, httpSchemes)
+				if err != nil {
+					return false, errors.Join(err, errors.New("SSV client pprof address was not a valid URL"))
+				}
+				urls = append(urls, url)
+			}
+		}
+		if len(urls) == 0 {
+			return false, errors.New("SSV client pprof addresses were empty, set the pprof or the metrics addresses")
+		}
+		if len(b.SSV.Addresses) != 0 && len(b.SSV.Addresses) != len(urls) {
+			return false, fmt.Errorf("SSV client pprof addresses count: %d did not match the addresses count: %d", len(urls), len(b.SSV.Addresses))
+		}
+		if len(b.SSV.Profiling.Metrics) == 0 {
+			return false, errors.New("SSV client profiling metrics were empty")
+		}
+		if b.SSV.Profiling.OutputDirectory == "" {
+			return false, errors.New("SSV client profiling output directory was empty")
+		}
+		if b.SSV.Profiling.CPUDuration <= 0 {
+			b.SSV.Profiling.CPUDuration = defaultProfilingCPUDuration
+		}
+		if b.SSV.Profiling.Cooldown <= 0 {
+			b.SSV.Profiling.Cooldown = defaultProfilingCooldown
+		}
+
+		b.SSV.Profiling.Addresses = urls
+	}
+
+	if b.Infrastructure.Metrics.DiskLatency.Enabled && len(b.Infrastructure.DiskPaths) == 0 {
+		return false, errors.New("infrastructure disk paths were empty, the disk latency metric requires them")
+	}
+	if b.Infrastructure.Metrics.Disk.Enabled && len(b.Infrastructure.DiskPaths) == 0 {
+		b.Infrastructure.DiskPaths = []string{defaultDiskPath}
+	}
+
+	if _, _, err := b.Consensus.ValidatorIDs(); err != nil {
+		return false, errors.Join(err, errors.New("consensus validators were not valid"))
+	}
+
+	for _, custom := range b.CustomNetworks {
+		name := network.Name(strings.ToLower(custom.Name))
+		if name == "" {
+			return false, errors.New("custom network name was empty")
+		}
+		if _, ok := network.Supported[name]; ok {
+			return false, fmt.Errorf("custom network name: '%s' conflicts with a supported network", custom.Name)
 		}
-		b.SSV.Address = url
 	}
 
-	network := network.Name(b.Network)
-	if err := network.Validate(); err != nil {
+	if _, err := b.NetworkParams(); err != nil {
 		return false, errors.Join(err, errors.New("network name was not valid"))
 	}
 
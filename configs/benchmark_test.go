@@ -59,7 +59,7 @@ func TestBenchmark_Validate(t *testing.T) {
 			name: "Valid config with SSV metrics",
 			cfg: Benchmark{
 				SSV: SSV{
-					Address: "http://localhost:8545",
+					Addresses: []string{"http://localhost:8545"},
 					Metrics: SSVMetrics{
 						Peers: Metric{Enabled: true},
 					},
@@ -69,6 +69,71 @@ func TestBenchmark_Validate(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Valid config with multiple named SSV nodes",
+			cfg: Benchmark{
+				SSV: SSV{
+					Addresses: []string{"http://localhost:16000;http://localhost:16001"},
+					Names:     []string{"operator-a", "operator-b"},
+					Metrics: SSVMetrics{
+						Health: Metric{Enabled: true},
+					},
+				},
+				Network: "mainnet",
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "SSV names not matching the addresses",
+			cfg: Benchmark{
+				SSV: SSV{
+					Addresses: []string{"http://localhost:16000", "http://localhost:16001"},
+					Names:     []string{"operator-a"},
+					Metrics: SSVMetrics{
+						Health: Metric{Enabled: true},
+					},
+				},
+				Network: "mainnet",
+			},
+			want:    false,
+			wantErr: true,
+			errMsg:  "SSV client names count: 1 did not match the addresses count: 2",
+		},
+		{
+			name: "SSV metrics addresses not matching the addresses",
+			cfg: Benchmark{
+				SSV: SSV{
+					Addresses:        []string{"http://localhost:16000", "http://localhost:16001"},
+					MetricsAddresses: []string{"http://localhost:15000"},
+					Metrics: SSVMetrics{
+						Scrape: Metric{Enabled: true},
+					},
+				},
+				Network: "mainnet",
+			},
+			want:    false,
+			wantErr: true,
+			errMsg:  "SSV client metrics addresses count: 1 did not match the addresses count: 2",
+		},
+		{
+			name: "SSV scrape measurement with unsupported condition operator",
+			cfg: Benchmark{
+				SSV: SSV{
+					MetricsAddresses: []string{"http://localhost:15000"},
+					Scrape: Scrape{Measurements: []ScrapeMeasurement{
+						{Name: "Rejections", Type: "increase", Selector: "ssv_message_validation", Conditions: []Condition{{Threshold: 1, Operator: "!=", Severity: "High"}}},
+					}},
+					Metrics: SSVMetrics{
+						Scrape: Metric{Enabled: true},
+					},
+				},
+				Network: "mainnet",
+			},
+			want:    false,
+			wantErr: true,
+			errMsg:  "condition operator: '!=' is not supported",
+		},
 		{
 			name: "Invalid network name",
 			cfg: Benchmark{
@@ -78,6 +143,76 @@ func TestBenchmark_Validate(t *testing.T) {
 			wantErr: true,
 			errMsg:  "network name was not valid",
 		},
+		{
+			name: "Custom network",
+			cfg: Benchmark{
+				Network: "kurtosis",
+				CustomNetworks: []Network{
+					{Name: "Kurtosis", SecondsPerSlot: 6, SlotsPerEpoch: 8},
+				},
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Custom network conflicting with supported network",
+			cfg: Benchmark{
+				Network: "mainnet",
+				CustomNetworks: []Network{
+					{Name: "mainnet", SecondsPerSlot: 6},
+				},
+			},
+			want:    false,
+			wantErr: true,
+			errMsg:  "conflicts with a supported network",
+		},
+		{
+			name: "Custom network without name",
+			cfg: Benchmark{
+				Network: "mainnet",
+				CustomNetworks: []Network{
+					{SecondsPerSlot: 6},
+				},
+			},
+			want:    false,
+			wantErr: true,
+			errMsg:  "custom network name was empty",
+		},
+		{
+			name: "Validator indices and public keys",
+			cfg: Benchmark{
+				Consensus: Consensus{
+					Validators: []string{"1234", "0x" + strings.Repeat("ab", 48)},
+				},
+				Network: "mainnet",
+			},
+			want:    true,
+			wantErr: false,
+		},
+		{
+			name: "Invalid validator public key",
+			cfg: Benchmark{
+				Consensus: Consensus{
+					Validators: []string{"0xabcd"},
+				},
+				Network: "mainnet",
+			},
+			want:    false,
+			wantErr: true,
+			errMsg:  "was not a valid BLS public key",
+		},
+		{
+			name: "Invalid validator index",
+			cfg: Benchmark{
+				Consensus: Consensus{
+					Validators: []string{"validator"},
+				},
+				Network: "mainnet",
+			},
+			want:    false,
+			wantErr: true,
+			errMsg:  "was neither a validator index nor a public key",
+		},
 		{
 			name: "Multiple consensus addresses with separator",
 			cfg: Benchmark{
@@ -176,6 +311,26 @@ func TestBenchmark_Validate(t *testing.T) {
 			want:    true,
 			wantErr: false,
 		},
+		{
+			name: "Disk latency metric without disk paths",
+			cfg: Benchmark{
+				Execution: Execution{
+					Addresses: []string{"http://localhost:8545"},
+					Metrics: ExecutionMetrics{
+						Peers: Metric{Enabled: true},
+					},
+				},
+				Infrastructure: Infrastructure{
+					Metrics: InfrastructureMetrics{
+						DiskLatency: Metric{Enabled: true},
+					},
+				},
+				Network: "mainnet",
+			},
+			want:    false,
+			wantErr: true,
+			errMsg:  "the disk latency metric requires them",
+		},
 	}
 
 	for _, tt := range tests {
@@ -4,17 +4,26 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strings"
 )
 
-var Values Config
+var (
+	Values Config
+
+	// httpSchemes lists the URL schemes of node addresses
+	httpSchemes = []string{"http", "https"}
+	// executionSchemes lists the URL schemes of execution client addresses, which can also be WebSocket endpoints
+	executionSchemes = []string{"http", "https", "ws", "wss"}
+)
 
 type Config struct {
 	Benchmark Benchmark `mapstructure:"benchmark"`
 	Analyzer  Analyzer  `mapstructure:"analyzer"`
 }
 
-func sanitizeURL(str string) (string, error) {
+// sanitizeURL validates the URL against the allowed schemes and strips it down to the scheme, host and path
+func sanitizeURL(str string, schemes []string) (string, error) {
 	parsedURL, err := url.Parse(str)
 	if err != nil {
 		return "", err
@@ -22,6 +31,8 @@ func sanitizeURL(str string) (string, error) {
 	var validationErr error
 	if parsedURL.Scheme == "" {
 		validationErr = errors.Join(validationErr, errors.New("scheme was empty"))
+	} else if !slices.Contains(schemes, strings.ToLower(parsedURL.Scheme)) {
+		validationErr = errors.Join(validationErr, fmt.Errorf("scheme: '%s' is not supported. List of supported schemes: '%v'", parsedURL.Scheme, schemes))
 	}
 	if parsedURL.Host == "" {
 		validationErr = errors.Join(validationErr, errors.New("host was empty"))
@@ -8,6 +8,7 @@ import (
 func TestSanitizeURL(t *testing.T) {
 	tests := []struct {
 		name, input, want, errMsg string
+		schemes                   []string
 		wantErr                   bool
 	}{
 		{
@@ -61,6 +62,34 @@ func TestSanitizeURL(t *testing.T) {
 			want:    "https://example.com",
 			wantErr: false,
 		},
+		{
+			name:    "WebSocket URL",
+			input:   "ws://example.com:8546/",
+			want:    "ws://example.com:8546",
+			schemes: executionSchemes,
+			wantErr: false,
+		},
+		{
+			name:    "Secure WebSocket URL with path",
+			input:   "wss://example.com/ws/key",
+			want:    "wss://example.com/ws/key",
+			schemes: executionSchemes,
+			wantErr: false,
+		},
+		{
+			name:    "WebSocket URL of a non execution address",
+			input:   "ws://example.com:8546",
+			want:    "",
+			wantErr: true,
+			errMsg:  "scheme: 'ws' is not supported",
+		},
+		{
+			name:    "URL with unsupported scheme",
+			input:   "ftp://example.com",
+			want:    "",
+			wantErr: true,
+			errMsg:  "scheme: 'ftp' is not supported",
+		},
 		{
 			name:    "Empty URL",
 			input:   "",
@@ -72,7 +101,11 @@ func TestSanitizeURL(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := sanitizeURL(tt.input)
+			schemes := tt.schemes
+			if schemes == nil {
+				schemes = httpSchemes
+			}
+			got, err := sanitizeURL(tt.input, schemes)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("sanitizeURL() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -23,24 +23,71 @@ const (
 	serverPortFlag    = "port"
 	defaultServerPort = 8080
 
-	consensusAddrFlag              = "consensus-addr"
-	consensusMetricClientFlag      = "consensus-metric-client-enabled"
-	consensusMetricLatencyFlag     = "consensus-metric-latency-enabled"
-	consensusMetricPeersFlag       = "consensus-metric-peers-enabled"
-	consensusMetricAttestationFlag = "consensus-metric-attestation-enabled"
+	consensusAddrFlag               = "consensus-addr"
+	consensusValidatorsFlag         = "consensus-validators"
+	consensusMetricClientFlag       = "consensus-metric-client-enabled"
+	consensusMetricLatencyFlag      = "consensus-metric-latency-enabled"
+	consensusMetricPeersFlag        = "consensus-metric-peers-enabled"
+	consensusMetricAttestationFlag  = "consensus-metric-attestation-enabled"
+	consensusMetricAgreementFlag    = "consensus-metric-agreement-enabled"
+	consensusMetricHealthFlag       = "consensus-metric-health-enabled"
+	consensusMetricPerformanceFlag  = "consensus-metric-performance-enabled"
+	consensusMetricInclusionFlag    = "consensus-metric-inclusion-enabled"
+	consensusMetricProposalFlag     = "consensus-metric-proposal-enabled"
+	consensusMetricSlashingFlag     = "consensus-metric-slashing-enabled"
+	consensusMetricNetworkFlag      = "consensus-metric-network-enabled"
+	consensusMetricReachabilityFlag = "consensus-metric-reachability-enabled"
+	consensusP2PHostFlag            = "consensus-p2p-host"
+	consensusP2PTCPPortFlag         = "consensus-p2p-tcp-port"
+	consensusP2PUDPPortFlag         = "consensus-p2p-udp-port"
+	defaultConsensusP2PPort         = 9000
 
-	executionAddrFlag          = "execution-addr"
-	executionMetricPeersFlag   = "execution-metric-peers-enabled"
-	executionMetricLatencyFlag = "execution-metric-latency-enabled"
+	executionAddrFlag              = "execution-addr"
+	executionMetricClientFlag      = "execution-metric-client-enabled"
+	executionMetricPeersFlag       = "execution-metric-peers-enabled"
+	executionMetricLatencyFlag     = "execution-metric-latency-enabled"
+	executionMetricNetworkFlag     = "execution-metric-network-enabled"
+	executionMetricSyncFlag        = "execution-metric-sync-enabled"
+	executionMetricRPCFlag         = "execution-metric-rpc-enabled"
+	executionMetricWebSocketFlag   = "execution-metric-websocket-enabled"
+	executionMetricEngineFlag      = "execution-metric-engine-enabled"
+	executionMetricConsistencyFlag = "execution-metric-consistency-enabled"
+	executionEngineAddrFlag        = "execution-engine-addr"
+	executionEngineJWTSecretFlag   = "execution-engine-jwt-secret-file"
 
-	ssvAddrFlag              = "ssv-addr"
-	ssvMetricPeersFlag       = "ssv-metric-peers-enabled"
-	ssvMetricConnectionsFlag = "ssv-metric-connections-enabled"
+	ssvAddrFlag               = "ssv-addr"
+	ssvNamesFlag              = "ssv-names"
+	ssvMetricsAddrFlag        = "ssv-metrics-addr"
+	ssvMetricClientFlag       = "ssv-metric-client-enabled"
+	ssvMetricSubnetsFlag      = "ssv-metric-subnets-enabled"
+	ssvMetricHealthFlag       = "ssv-metric-health-enabled"
+	ssvMetricPeersFlag        = "ssv-metric-peers-enabled"
+	ssvMetricConnectionsFlag  = "ssv-metric-connections-enabled"
+	ssvMetricScrapeFlag       = "ssv-metric-scrape-enabled"
+	ssvMetricEventSyncerFlag  = "ssv-metric-event-syncer-enabled"
+	ssvMetricReachabilityFlag = "ssv-metric-reachability-enabled"
+	ssvMetricProfilingFlag    = "ssv-metric-profiling-enabled"
+	ssvPprofAddrFlag          = "ssv-pprof-addr"
+	ssvProfilingMetricsFlag   = "ssv-profiling-metrics"
+	ssvProfilingOutputFlag    = "ssv-profiling-output-dir"
+	ssvP2PHostFlag            = "ssv-p2p-host"
+	ssvP2PTCPPortFlag         = "ssv-p2p-tcp-port"
+	ssvP2PUDPPortFlag         = "ssv-p2p-udp-port"
+	defaultSSVP2PTCPPort      = 13001
+	defaultSSVP2PUDPPort      = 12001
 
-	infraMetricCPUFlag    = "infra-metric-cpu-enabled"
-	infraMetricMemoryFlag = "infra-metric-memory-enabled"
+	infraMetricCPUFlag         = "infra-metric-cpu-enabled"
+	infraMetricMemoryFlag      = "infra-metric-memory-enabled"
+	infraMetricDiskFlag        = "infra-metric-disk-enabled"
+	infraMetricDiskLatencyFlag = "infra-metric-disk-latency-enabled"
+	infraDiskPathsFlag         = "infra-disk-paths"
+	infraMetricNetworkFlag     = "infra-metric-network-enabled"
+	infraNetworkInterfacesFlag = "infra-network-interfaces"
+	infraMetricProcessFlag     = "infra-metric-process-enabled"
+	infraProcessesFlag         = "infra-processes"
 
-	networkFlag = "network"
+	networkFlag           = "network"
+	versionPolicyFileFlag = "version-policy-file"
 )
 
 func init() {
@@ -69,7 +116,17 @@ var CMD = &cobra.Command{
 			panic(err.Error())
 		}
 
-		metrics, err := LoadEnabledMetrics(configs.Values)
+		network, err := LoadNetwork(ctx, configs.Values.Benchmark)
+		if err != nil {
+			panic(err.Error())
+		}
+
+		validators, err := LoadValidators(ctx, configs.Values.Benchmark)
+		if err != nil {
+			panic(err.Error())
+		}
+
+		metrics, err := LoadEnabledMetrics(configs.Values, network, validators)
 		if err != nil {
 			panic(err.Error())
 		}
@@ -97,23 +154,67 @@ func addFlags(cobraCMD *cobra.Command) {
 	cobraCMD.Flags().Duration(durationFlag, defaultExecutionDuration, "Duration for which the application will run to gather metrics, e.g. '5m'")
 	cobraCMD.Flags().Uint16(serverPortFlag, defaultServerPort, "Web server port with metrics endpoint exposed, e.g. '8080'")
 	cobraCMD.Flags().String(consensusAddrFlag, "", "A comma-separated list of consensus client addresses, including the scheme (HTTP/HTTPS) and port, e.g. `https://lighthouse:5052,https://prysm:5052`.")
+	cobraCMD.Flags().String(consensusValidatorsFlag, "", "A comma-separated list of indices or public keys of the validators run by the SSV cluster, e.g. `1234,0xa1b2...`")
 	cobraCMD.Flags().Bool(consensusMetricClientFlag, true, "Enable consensus client metric")
 	cobraCMD.Flags().Bool(consensusMetricLatencyFlag, true, "Enable consensus client latency metric")
 	cobraCMD.Flags().Bool(consensusMetricPeersFlag, true, "Enable consensus client peers metric")
 	cobraCMD.Flags().Bool(consensusMetricAttestationFlag, true, "Enable consensus client attestation metric")
+	cobraCMD.Flags().Bool(consensusMetricHealthFlag, true, "Enable consensus client node health metric")
+	cobraCMD.Flags().Bool(consensusMetricPerformanceFlag, true, "Enable validator performance metric. Requires validators to be configured")
+	cobraCMD.Flags().Bool(consensusMetricInclusionFlag, true, "Enable attestation inclusion delay metric. Requires validators to be configured")
+	cobraCMD.Flags().Bool(consensusMetricProposalFlag, true, "Enable block proposal metric. Requires validators to be configured")
+	cobraCMD.Flags().Bool(consensusMetricSlashingFlag, true, "Enable slashing metric. Requires validators to be configured")
+	cobraCMD.Flags().Bool(consensusMetricNetworkFlag, true, "Enable consensus client network consistency metric")
+	cobraCMD.Flags().Bool(consensusMetricAgreementFlag, true, "Enable agreement metric across consensus clients. Requires at least two consensus client addresses")
+	cobraCMD.Flags().Bool(consensusMetricReachabilityFlag, false, "Enable consensus client P2P port reachability metric. Set the consensus P2P host when the beacon API is not served by the P2P host")
+	cobraCMD.Flags().String(consensusP2PHostFlag, "", "A comma-separated list of hosts to probe the consensus client P2P ports on, in the same order as the consensus client addresses. Defaults to the hosts of the addresses")
+	cobraCMD.Flags().Uint16(consensusP2PTCPPortFlag, defaultConsensusP2PPort, "Consensus client P2P TCP port, 0 disables the probe")
+	cobraCMD.Flags().Uint16(consensusP2PUDPPortFlag, defaultConsensusP2PPort, "Consensus client discovery UDP port, 0 disables the probe")
 
-	cobraCMD.Flags().String(executionAddrFlag, "", "A comma-separated list of execution client addresses, including the scheme (HTTP/HTTPS) and port, e.g. `https://geth:8545,https://reth:8545`.")
+	cobraCMD.Flags().String(executionAddrFlag, "", "A comma-separated list of execution client addresses, including the scheme (HTTP/HTTPS/WS/WSS) and port, e.g. `https://geth:8545,ws://reth:8546`.")
+	cobraCMD.Flags().Bool(executionMetricClientFlag, true, "Enable execution client version and required RPC methods metric")
 	cobraCMD.Flags().Bool(executionMetricPeersFlag, true, "Enable execution client peers metric")
 	cobraCMD.Flags().Bool(executionMetricLatencyFlag, true, "Enable execution client latency metric")
+	cobraCMD.Flags().Bool(executionMetricNetworkFlag, true, "Enable execution client network consistency metric")
+	cobraCMD.Flags().Bool(executionMetricSyncFlag, true, "Enable execution client sync and head freshness metric")
+	cobraCMD.Flags().Bool(executionMetricRPCFlag, true, "Enable execution client JSON-RPC method latency metric")
+	cobraCMD.Flags().String(executionEngineAddrFlag, "", "A comma-separated list of authenticated engine API addresses of the execution clients, including the scheme (HTTP/HTTPS) and port, e.g. `http://geth:8551`. Listed in the same order as the execution client addresses")
+	cobraCMD.Flags().String(executionEngineJWTSecretFlag, "", "Path to the hex encoded JWT secret shared by the consensus and execution clients")
+	cobraCMD.Flags().Bool(executionMetricEngineFlag, true, "Enable execution client engine API connectivity and JWT authentication metric. Requires engine API addresses")
+	cobraCMD.Flags().Bool(executionMetricConsistencyFlag, true, "Enable head consistency metric between each execution client and the consensus client at the same position in the address list")
+	cobraCMD.Flags().Bool(executionMetricWebSocketFlag, true, "Enable execution client WebSocket newHeads subscription metric. Applies to WebSocket addresses only")
 
-	cobraCMD.Flags().String(ssvAddrFlag, "", "SSV API address with scheme (HTTP/HTTPS) and port, e.g. http://ssv-node:16000")
+	cobraCMD.Flags().String(ssvAddrFlag, "", "A comma-separated list of SSV API addresses with scheme (HTTP/HTTPS) and port, e.g. `http://ssv-node-1:16000,http://ssv-node-2:16000`")
+	cobraCMD.Flags().String(ssvNamesFlag, "", "A comma-separated list of human-readable names of the SSV nodes, in the same order as the SSV addresses, e.g. `operator-a,operator-b`")
+	cobraCMD.Flags().String(ssvMetricsAddrFlag, "", "A comma-separated list of SSV Prometheus metrics addresses with scheme (HTTP/HTTPS) and port, in the same order as the SSV addresses, e.g. `http://ssv-node-1:15000`")
+	cobraCMD.Flags().Bool(ssvMetricClientFlag, true, "Enable SSV client identity and version metric")
+	cobraCMD.Flags().Bool(ssvMetricSubnetsFlag, true, "Enable SSV client subnet peer coverage metric")
+	cobraCMD.Flags().Bool(ssvMetricHealthFlag, true, "Enable SSV client node health metric")
 	cobraCMD.Flags().Bool(ssvMetricPeersFlag, true, "Enable SSV client peers metric")
 	cobraCMD.Flags().Bool(ssvMetricConnectionsFlag, true, "Enable SSV client connections metric")
+	cobraCMD.Flags().Bool(ssvMetricScrapeFlag, true, "Enable measurements derived from the SSV client Prometheus metrics. Requires SSV metrics addresses")
+	cobraCMD.Flags().Bool(ssvMetricReachabilityFlag, false, "Enable SSV client P2P port reachability metric. Set the SSV P2P host when the API is not served by the P2P host")
+	cobraCMD.Flags().String(ssvP2PHostFlag, "", "A comma-separated list of hosts to probe the SSV P2P ports on, in the same order as the SSV addresses. Defaults to the hosts of the addresses")
+	cobraCMD.Flags().Uint16(ssvP2PTCPPortFlag, defaultSSVP2PTCPPort, "SSV client P2P TCP port, 0 disables the probe")
+	cobraCMD.Flags().Uint16(ssvP2PUDPPortFlag, defaultSSVP2PUDPPort, "SSV client discovery UDP port, 0 disables the probe")
+	cobraCMD.Flags().Bool(ssvMetricProfilingFlag, false, "Enable capturing CPU, heap and goroutine profiles of the SSV client when one of the profiling metrics reaches High severity")
+	cobraCMD.Flags().String(ssvPprofAddrFlag, "", "A comma-separated list of SSV pprof addresses with scheme (HTTP/HTTPS) and port, in the same order as the SSV addresses. Defaults to the SSV metrics addresses")
+	cobraCMD.Flags().String(ssvProfilingMetricsFlag, "", "A comma-separated list of metric names triggering a profile capture, e.g. `Health,EventSyncer,CPU`")
+	cobraCMD.Flags().String(ssvProfilingOutputFlag, "", "Directory the captured profiles are saved to")
+	cobraCMD.Flags().Bool(ssvMetricEventSyncerFlag, true, "Enable SSV client event syncer lag metric. Requires SSV metrics addresses and execution client addresses")
 
 	cobraCMD.Flags().Bool(infraMetricCPUFlag, true, "Enable infrastructure CPU metric")
 	cobraCMD.Flags().Bool(infraMetricMemoryFlag, true, "Enable infrastructure memory metric")
+	cobraCMD.Flags().Bool(infraMetricDiskFlag, true, "Enable infrastructure disk free space and inodes metric")
+	cobraCMD.Flags().Bool(infraMetricDiskLatencyFlag, false, "Enable infrastructure disk fsync latency metric. Writes a small scratch file to every disk path set by the disk paths flag")
+	cobraCMD.Flags().String(infraDiskPathsFlag, "", "A comma-separated list of paths monitored by the disk metrics, e.g. `/data/ssv,/data/beacon`. The disk metric defaults to `/`, the disk latency metric requires the paths")
+	cobraCMD.Flags().Bool(infraMetricNetworkFlag, true, "Enable infrastructure network interface throughput, drops, errors and TCP retransmission metrics")
+	cobraCMD.Flags().String(infraNetworkInterfacesFlag, "", "A comma-separated list of network interfaces monitored by the network metric, e.g. `eth0,eth1`. Defaults to the physical interfaces, virtual ones such as docker0 and veth are left out")
+	cobraCMD.Flags().Bool(infraMetricProcessFlag, true, "Enable infrastructure process metric, scoped to the processes set by the processes flag")
+	cobraCMD.Flags().String(infraProcessesFlag, "", "A comma-separated list of processes monitored by the process metric in the '[label=]kind:value' format where kind is one of 'pid', 'name' or 'cgroup', e.g. `ssv=cgroup:/system.slice/ssv.service,geth=name:geth,lighthouse=pid:1234`")
 
-	cobraCMD.Flags().String(networkFlag, "", "Ethereum network to use, either 'mainnet' or 'holesky'")
+	cobraCMD.Flags().String(versionPolicyFileFlag, "", "Path to a YAML file with minimum and blocked client versions per client and network")
+	cobraCMD.Flags().String(networkFlag, "", "Ethereum network to use, either one of the supported networks ('mainnet', 'holesky', 'hoodi', 'sepolia') or a name of a custom network defined in the configuration file")
 }
 
 func bindFlags(cmd *cobra.Command) error {
@@ -126,15 +227,33 @@ func bindFlags(cmd *cobra.Command) error {
 	if err := viper.BindPFlag("benchmark.consensus.address", cmd.Flags().Lookup(consensusAddrFlag)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("benchmark.consensus.validators", cmd.Flags().Lookup(consensusValidatorsFlag)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag("benchmark.execution.address", cmd.Flags().Lookup(executionAddrFlag)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("benchmark.execution.engine.address", cmd.Flags().Lookup(executionEngineAddrFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.execution.engine.jwt-secret-file", cmd.Flags().Lookup(executionEngineJWTSecretFlag)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag("benchmark.ssv.address", cmd.Flags().Lookup(ssvAddrFlag)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("benchmark.ssv.names", cmd.Flags().Lookup(ssvNamesFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.metrics-address", cmd.Flags().Lookup(ssvMetricsAddrFlag)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag("benchmark.network", cmd.Flags().Lookup(networkFlag)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("benchmark.version-policy-file", cmd.Flags().Lookup(versionPolicyFileFlag)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag("benchmark.consensus.metrics.client.enabled", cmd.Flags().Lookup(consensusMetricClientFlag)); err != nil {
 		return err
 	}
@@ -147,24 +266,138 @@ func bindFlags(cmd *cobra.Command) error {
 	if err := viper.BindPFlag("benchmark.consensus.metrics.attestation.enabled", cmd.Flags().Lookup(consensusMetricAttestationFlag)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("benchmark.consensus.metrics.health.enabled", cmd.Flags().Lookup(consensusMetricHealthFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.metrics.performance.enabled", cmd.Flags().Lookup(consensusMetricPerformanceFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.metrics.inclusion.enabled", cmd.Flags().Lookup(consensusMetricInclusionFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.metrics.proposal.enabled", cmd.Flags().Lookup(consensusMetricProposalFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.metrics.slashing.enabled", cmd.Flags().Lookup(consensusMetricSlashingFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.metrics.network.enabled", cmd.Flags().Lookup(consensusMetricNetworkFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.metrics.agreement.enabled", cmd.Flags().Lookup(consensusMetricAgreementFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.execution.metrics.client.enabled", cmd.Flags().Lookup(executionMetricClientFlag)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag("benchmark.execution.metrics.peers.enabled", cmd.Flags().Lookup(executionMetricPeersFlag)); err != nil {
 		return err
 	}
 	if err := viper.BindPFlag("benchmark.execution.metrics.latency.enabled", cmd.Flags().Lookup(executionMetricLatencyFlag)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("benchmark.execution.metrics.network.enabled", cmd.Flags().Lookup(executionMetricNetworkFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.execution.metrics.sync.enabled", cmd.Flags().Lookup(executionMetricSyncFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.execution.metrics.rpc.enabled", cmd.Flags().Lookup(executionMetricRPCFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.execution.metrics.websocket.enabled", cmd.Flags().Lookup(executionMetricWebSocketFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.execution.metrics.engine.enabled", cmd.Flags().Lookup(executionMetricEngineFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.execution.metrics.consistency.enabled", cmd.Flags().Lookup(executionMetricConsistencyFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.metrics.client.enabled", cmd.Flags().Lookup(ssvMetricClientFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.metrics.subnets.enabled", cmd.Flags().Lookup(ssvMetricSubnetsFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.metrics.health.enabled", cmd.Flags().Lookup(ssvMetricHealthFlag)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag("benchmark.ssv.metrics.peers.enabled", cmd.Flags().Lookup(ssvMetricPeersFlag)); err != nil {
 		return err
 	}
 	if err := viper.BindPFlag("benchmark.ssv.metrics.connections.enabled", cmd.Flags().Lookup(ssvMetricConnectionsFlag)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("benchmark.ssv.metrics.scrape.enabled", cmd.Flags().Lookup(ssvMetricScrapeFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.metrics.event-syncer.enabled", cmd.Flags().Lookup(ssvMetricEventSyncerFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.metrics.profiling.enabled", cmd.Flags().Lookup(ssvMetricProfilingFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.profiling.address", cmd.Flags().Lookup(ssvPprofAddrFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.profiling.metrics", cmd.Flags().Lookup(ssvProfilingMetricsFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.profiling.output-directory", cmd.Flags().Lookup(ssvProfilingOutputFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.metrics.reachability.enabled", cmd.Flags().Lookup(consensusMetricReachabilityFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.p2p.host", cmd.Flags().Lookup(consensusP2PHostFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.p2p.tcp-port", cmd.Flags().Lookup(consensusP2PTCPPortFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.consensus.p2p.udp-port", cmd.Flags().Lookup(consensusP2PUDPPortFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.metrics.reachability.enabled", cmd.Flags().Lookup(ssvMetricReachabilityFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.p2p.host", cmd.Flags().Lookup(ssvP2PHostFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.p2p.tcp-port", cmd.Flags().Lookup(ssvP2PTCPPortFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.ssv.p2p.udp-port", cmd.Flags().Lookup(ssvP2PUDPPortFlag)); err != nil {
+		return err
+	}
 	if err := viper.BindPFlag("benchmark.infrastructure.metrics.cpu.enabled", cmd.Flags().Lookup(infraMetricCPUFlag)); err != nil {
 		return err
 	}
 	if err := viper.BindPFlag("benchmark.infrastructure.metrics.memory.enabled", cmd.Flags().Lookup(infraMetricMemoryFlag)); err != nil {
 		return err
 	}
+	if err := viper.BindPFlag("benchmark.infrastructure.metrics.disk.enabled", cmd.Flags().Lookup(infraMetricDiskFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.infrastructure.metrics.disk-latency.enabled", cmd.Flags().Lookup(infraMetricDiskLatencyFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.infrastructure.disk-paths", cmd.Flags().Lookup(infraDiskPathsFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.infrastructure.metrics.network.enabled", cmd.Flags().Lookup(infraMetricNetworkFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.infrastructure.network-interfaces", cmd.Flags().Lookup(infraNetworkInterfacesFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.infrastructure.metrics.process.enabled", cmd.Flags().Lookup(infraMetricProcessFlag)); err != nil {
+		return err
+	}
+	if err := viper.BindPFlag("benchmark.infrastructure.processes", cmd.Flags().Lookup(infraProcessesFlag)); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -3,28 +3,67 @@ package benchmark
 import (
 	"errors"
 	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
 	"github.com/ssvlabs/ssv-pulse/configs"
 	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/consensus"
 	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/execution"
 	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/infrastructure"
+	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/p2p"
 	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/ssv"
 	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
 	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
 )
 
-func LoadEnabledMetrics(config configs.Config) (map[metric.Group][]metricService, error) {
+func LoadEnabledMetrics(config configs.Config, network network.Network, validators []phase0.ValidatorIndex) (map[metric.Group][]metricService, error) {
 	enabledMetrics := make(map[metric.Group][]metricService)
 
+	var versionPolicy version.Policy
+	if config.Benchmark.VersionPolicyFile != "" {
+		policy, err := version.LoadPolicy(config.Benchmark.VersionPolicyFile)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed loading version policy"))
+		}
+		versionPolicy = policy
+	}
+
+	// nodes are compared with the statically known network parameters rather than with the ones fetched from the consensus client
+	expectedNetwork, err := config.Benchmark.NetworkParams()
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed loading expected network parameters"))
+	}
+	expectedNetwork.GenesisTime = network.GenesisTime
+	expectedNetwork.SecondsPerSlot = network.SecondsPerSlot
+	expectedNetwork.SlotsPerEpoch = network.SlotsPerEpoch
+
+	// nodes usually do not respond to the UDP probe, so only a refused UDP datagram is reported
+	reachabilityConditions := []metric.HealthCondition[string]{
+		{Name: p2p.TCPMeasurement, Threshold: p2p.StatusClosed, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+		{Name: p2p.TCPMeasurement, Threshold: p2p.StatusFiltered, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+		{Name: p2p.UDPMeasurement, Threshold: p2p.StatusClosed, Operator: metric.OperatorEqual, Severity: metric.SeverityMedium},
+	}
+
 	if config.Benchmark.Consensus.Metrics.Client.Enabled {
 		for i, addr := range configs.Values.Benchmark.Consensus.Addresses {
 			enabledMetrics[metric.Group(metric.Group(fmt.Sprintf("%s-%d", metric.ConsensusGroup, i+1)))] = append(enabledMetrics[metric.Group(metric.Group(fmt.Sprintf("%s-%d", metric.ConsensusGroup, i+1)))],
 				consensus.NewClientMetric(
 					addr,
 					"Client",
+					time.Minute,
+					network.Name,
+					versionPolicy,
 					[]metric.HealthCondition[string]{
 						{Name: consensus.VersionMeasurement, Threshold: "", Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: consensus.VersionPolicyMeasurement, Threshold: string(version.StatusBlocked), Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: consensus.VersionPolicyMeasurement, Threshold: string(version.StatusOutdated), Operator: metric.OperatorEqual, Severity: metric.SeverityMedium},
+						{Name: consensus.VersionPolicyMeasurement, Threshold: string(version.StatusUnknown), Operator: metric.OperatorEqual, Severity: metric.SeverityLow},
 					}))
 		}
 	}
@@ -57,6 +96,24 @@ func LoadEnabledMetrics(config configs.Config) (map[metric.Group][]metricService
 						{Name: consensus.PeerCountMeasurement, Threshold: 5, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityHigh},
 						{Name: consensus.PeerCountMeasurement, Threshold: 20, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityMedium},
 						{Name: consensus.PeerCountMeasurement, Threshold: 40, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityLow},
+						{Name: consensus.InboundMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: consensus.OutboundMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+					}))
+		}
+	}
+
+	if config.Benchmark.Consensus.Metrics.Health.Enabled {
+		for i, addr := range configs.Values.Benchmark.Consensus.Addresses {
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ConsensusGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ConsensusGroup, i+1))],
+				consensus.NewHealthMetric(
+					addr,
+					"Health",
+					time.Second*10,
+					[]metric.HealthCondition[uint32]{
+						{Name: consensus.StatusCodeMeasurement, Threshold: consensus.UnreachableStatusCode, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: consensus.StatusCodeMeasurement, Threshold: http.StatusServiceUnavailable, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: consensus.StatusCodeMeasurement, Threshold: http.StatusPartialContent, Operator: metric.OperatorEqual, Severity: metric.SeverityMedium},
+						{Name: consensus.TransitionMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityLow},
 					}))
 		}
 	}
@@ -67,7 +124,7 @@ func LoadEnabledMetrics(config configs.Config) (map[metric.Group][]metricService
 				consensus.NewAttestationMetric(
 					addr,
 					"Attestation",
-					network.Supported[network.Name(config.Benchmark.Network)].GenesisTime,
+					network,
 					[]metric.HealthCondition[float64]{
 						{Name: consensus.CorrectnessMeasurement, Threshold: 97, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityHigh},
 						{Name: consensus.CorrectnessMeasurement, Threshold: 98.5, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityMedium},
@@ -76,6 +133,130 @@ func LoadEnabledMetrics(config configs.Config) (map[metric.Group][]metricService
 		}
 	}
 
+	if config.Benchmark.Consensus.Metrics.Agreement.Enabled && len(configs.Values.Benchmark.Consensus.Addresses) > 1 {
+		enabledMetrics[metric.ConsensusGroup] = append(enabledMetrics[metric.ConsensusGroup],
+			consensus.NewAgreementMetric(
+				configs.Values.Benchmark.Consensus.Addresses,
+				"Agreement",
+				network,
+				[]metric.HealthCondition[float64]{
+					{Name: consensus.DisagreementRateMeasurement, Threshold: 5, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					{Name: consensus.DisagreementRateMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+				},
+			))
+	}
+
+	if config.Benchmark.Consensus.Metrics.Network.Enabled {
+		for i, addr := range configs.Values.Benchmark.Consensus.Addresses {
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ConsensusGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ConsensusGroup, i+1))],
+				consensus.NewNetworkMetric(
+					addr,
+					"Network",
+					time.Minute,
+					expectedNetwork,
+					[]metric.HealthCondition[uint32]{
+						{Name: consensus.NetworkMismatchMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: consensus.UnscheduledForkMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					}))
+		}
+	}
+
+	if config.Benchmark.Consensus.Metrics.Reachability.Enabled {
+		for i, addr := range configs.Values.Benchmark.Consensus.Addresses {
+			host, err := config.Benchmark.Consensus.P2P.Host(i, addr)
+			if err != nil {
+				return nil, errors.Join(err, errors.New("failed fetching Consensus client P2P host"))
+			}
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ConsensusGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ConsensusGroup, i+1))],
+				p2p.NewReachabilityMetric(
+					metric.ConsensusGroup,
+					host,
+					config.Benchmark.Consensus.P2P.TCPPort,
+					config.Benchmark.Consensus.P2P.UDPPort,
+					"Reachability",
+					time.Minute,
+					reachabilityConditions))
+		}
+	}
+
+	if config.Benchmark.Consensus.Metrics.Performance.Enabled && len(validators) != 0 {
+		enabledMetrics[metric.ValidatorGroup] = append(enabledMetrics[metric.ValidatorGroup],
+			consensus.NewPerformanceMetric(
+				configs.Values.Benchmark.Consensus.Addresses,
+				"Performance",
+				network,
+				validators,
+				[]metric.HealthCondition[float64]{
+					{Name: consensus.OfflineValidatorsMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					{Name: consensus.MissedAttestationsMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					{Name: consensus.LateAttestationsMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityLow},
+					{Name: consensus.TargetCorrectnessMeasurement, Threshold: 95, Operator: metric.OperatorLessThan, Severity: metric.SeverityMedium},
+					{Name: consensus.HeadCorrectnessMeasurement, Threshold: 90, Operator: metric.OperatorLessThan, Severity: metric.SeverityLow},
+				},
+			))
+	}
+
+	if config.Benchmark.Consensus.Metrics.Inclusion.Enabled && len(validators) != 0 {
+		enabledMetrics[metric.ValidatorGroup] = append(enabledMetrics[metric.ValidatorGroup],
+			consensus.NewInclusionMetric(
+				configs.Values.Benchmark.Consensus.Addresses,
+				"Inclusion",
+				network,
+				validators,
+				[]metric.HealthCondition[float64]{
+					{Name: consensus.NotIncludedMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					{Name: consensus.AverageInclusionDistanceMeasurement, Threshold: 2, Operator: metric.OperatorGreaterThan, Severity: metric.SeverityMedium},
+					{Name: consensus.AverageInclusionDistanceMeasurement, Threshold: 1.5, Operator: metric.OperatorGreaterThan, Severity: metric.SeverityLow},
+				},
+			))
+	}
+
+	if config.Benchmark.Consensus.Metrics.Proposal.Enabled && len(validators) != 0 {
+		enabledMetrics[metric.ValidatorGroup] = append(enabledMetrics[metric.ValidatorGroup],
+			consensus.NewProposalMetric(
+				configs.Values.Benchmark.Consensus.Addresses,
+				"Proposal",
+				network,
+				validators,
+				[]metric.HealthCondition[float64]{
+					{Name: consensus.MissedProposalMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+				},
+			))
+	}
+
+	if config.Benchmark.Consensus.Metrics.Slashing.Enabled && len(validators) != 0 {
+		enabledMetrics[metric.ValidatorGroup] = append(enabledMetrics[metric.ValidatorGroup],
+			consensus.NewSlashingMetric(
+				configs.Values.Benchmark.Consensus.Addresses,
+				"Slashing",
+				network,
+				validators,
+				[]metric.HealthCondition[float64]{
+					{Name: consensus.SlashedValidatorMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+				},
+			))
+	}
+
+	if config.Benchmark.Execution.Metrics.Client.Enabled {
+		for i, addr := range configs.Values.Benchmark.Execution.Addresses {
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))],
+				execution.NewClientMetric(
+					addr,
+					"Client",
+					time.Minute,
+					network.Name,
+					versionPolicy,
+					[]metric.HealthCondition[string]{
+						{Name: execution.VersionMeasurement, Threshold: "", Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: execution.VersionPolicyMeasurement, Threshold: string(version.StatusBlocked), Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: execution.VersionPolicyMeasurement, Threshold: string(version.StatusOutdated), Operator: metric.OperatorEqual, Severity: metric.SeverityMedium},
+						{Name: execution.VersionPolicyMeasurement, Threshold: string(version.StatusUnknown), Operator: metric.OperatorEqual, Severity: metric.SeverityLow},
+						// any non-empty list of missing methods
+						{Name: execution.MissingMethodsMeasurement, Threshold: "", Operator: metric.OperatorGreaterThan, Severity: metric.SeverityHigh},
+					}))
+		}
+	}
+
 	if config.Benchmark.Execution.Metrics.Peers.Enabled {
 		for i, addr := range configs.Values.Benchmark.Execution.Addresses {
 			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))],
@@ -108,26 +289,307 @@ func LoadEnabledMetrics(config configs.Config) (map[metric.Group][]metricService
 		}
 	}
 
-	if config.Benchmark.SSV.Metrics.Peers.Enabled {
-		enabledMetrics[metric.SSVGroup] = append(enabledMetrics[metric.SSVGroup], ssv.NewPeerMetric(
-			configs.Values.Benchmark.SSV.Address,
-			"Peers",
-			time.Second*10,
-			[]metric.HealthCondition[uint32]{
-				{Name: ssv.PeerCountMeasurement, Threshold: 5, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityHigh},
-				{Name: ssv.PeerCountMeasurement, Threshold: 10, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityMedium},
-			}))
+	if config.Benchmark.Execution.Metrics.Network.Enabled {
+		for i, addr := range configs.Values.Benchmark.Execution.Addresses {
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))],
+				execution.NewNetworkMetric(
+					addr,
+					"Network",
+					time.Minute,
+					expectedNetwork,
+					[]metric.HealthCondition[uint32]{
+						{Name: execution.NetworkMismatchMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					}))
+		}
+	}
+
+	if config.Benchmark.Execution.Metrics.Sync.Enabled {
+		for i, addr := range configs.Values.Benchmark.Execution.Addresses {
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))],
+				execution.NewSyncMetric(
+					addr,
+					configs.Values.Benchmark.Consensus.Addresses,
+					"Sync",
+					time.Second*12,
+					[]metric.HealthCondition[float64]{
+						{Name: execution.SyncingMeasurement, Threshold: 1, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: execution.HeadAgeMeasurement, Threshold: 60, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.HeadAgeMeasurement, Threshold: 30, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.HeadLagMeasurement, Threshold: 5, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.HeadLagMeasurement, Threshold: 2, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					}))
+		}
+	}
+
+	if config.Benchmark.Execution.Metrics.RPC.Enabled {
+		methods := config.Benchmark.Execution.RPC.Methods
+		if len(methods) == 0 {
+			methods = execution.SupportedRPCMethods
+		}
+		if err := execution.ValidateRPCMethods(methods); err != nil {
+			return nil, errors.Join(err, errors.New("execution RPC methods were not valid"))
+		}
+		logsRange := config.Benchmark.Execution.RPC.LogsRange
+		if logsRange == 0 {
+			logsRange = 1000
+		}
+		timeout := config.Benchmark.Execution.RPC.Timeout
+		if timeout == 0 {
+			timeout = time.Second * 10
+		}
+		if expectedNetwork.SSVNetworkContract == "" {
+			slog.
+				With("network", expectedNetwork.Name).
+				Warn("SSV network contract is unknown, eth_getLogs and eth_call will not be timed")
+		}
+
+		for i, addr := range configs.Values.Benchmark.Execution.Addresses {
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))],
+				execution.NewRPCLatencyMetric(
+					addr,
+					"RPC",
+					time.Second*12,
+					timeout,
+					methods,
+					expectedNetwork.SSVNetworkContract,
+					logsRange,
+					[]metric.HealthCondition[float64]{
+						{Name: execution.RPCMeasurement(execution.BlockNumberMethod, execution.RPCDurationP90Measurement), Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.RPCMeasurement(execution.GetLogsMethod, execution.RPCDurationP90Measurement), Threshold: 5, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.RPCMeasurement(execution.GetLogsMethod, execution.RPCDurationP90Measurement), Threshold: 2, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.RPCMeasurement(execution.CallMethod, execution.RPCDurationP90Measurement), Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.RPCMeasurement(execution.BlockNumberMethod, execution.RPCTimeoutsMeasurement), Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.RPCMeasurement(execution.GetLogsMethod, execution.RPCTimeoutsMeasurement), Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.RPCMeasurement(execution.CallMethod, execution.RPCTimeoutsMeasurement), Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.RPCMeasurement(execution.BlockNumberMethod, execution.RPCErrorsMeasurement), Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.RPCMeasurement(execution.GetLogsMethod, execution.RPCErrorsMeasurement), Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.RPCMeasurement(execution.CallMethod, execution.RPCErrorsMeasurement), Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					}))
+		}
 	}
 
+	if config.Benchmark.Execution.Metrics.WebSocket.Enabled {
+		for i, addr := range configs.Values.Benchmark.Execution.Addresses {
+			if !strings.HasPrefix(addr, "ws://") && !strings.HasPrefix(addr, "wss://") {
+				continue
+			}
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))],
+				execution.NewWebSocketMetric(
+					addr,
+					"WebSocket",
+					time.Second*5,
+					network.SlotDuration()*3,
+					[]metric.HealthCondition[float64]{
+						{Name: execution.SubscriptionDropMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.BlockDelayMeasurement, Threshold: network.SlotDuration().Seconds(), Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.BlockDelayMeasurement, Threshold: network.SlotDuration().Seconds() / 2, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.HandshakeDurationMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					}))
+		}
+	}
+
+	if config.Benchmark.Execution.Metrics.Consistency.Enabled {
+		// consensus and execution clients are paired by their position in the address lists
+		for i, addr := range configs.Values.Benchmark.Execution.Addresses {
+			if i >= len(configs.Values.Benchmark.Consensus.Addresses) {
+				break
+			}
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))],
+				execution.NewConsistencyMetric(
+					addr,
+					configs.Values.Benchmark.Consensus.Addresses[i],
+					"Consistency",
+					network.SlotDuration(),
+					[]metric.HealthCondition[float64]{
+						{Name: execution.MismatchRateMeasurement, Threshold: 10, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.MismatchRateMeasurement, Threshold: 2, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.BlockLagMeasurement, Threshold: 5, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.BlockLagMeasurement, Threshold: 2, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					}))
+		}
+	}
+
+	if config.Benchmark.Execution.Metrics.Engine.Enabled && len(config.Benchmark.Execution.Engine.Addresses) != 0 {
+		secret, err := execution.LoadJWTSecret(config.Benchmark.Execution.Engine.JWTSecretFile)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed loading engine API JWT secret"))
+		}
+
+		for i, addr := range config.Benchmark.Execution.Engine.Addresses {
+			enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))] = append(enabledMetrics[metric.Group(fmt.Sprintf("%s-%d", metric.ExecutionGroup, i+1))],
+				execution.NewEngineMetric(
+					addr,
+					"Engine",
+					time.Second*12,
+					secret,
+					expectedNetwork.ChainID,
+					[]metric.HealthCondition[float64]{
+						{Name: execution.EngineAuthFailureMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.EngineUnreachableMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.EngineDurationMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: execution.EngineDurationMeasurement, Threshold: 0.5, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: execution.NetworkMismatchMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					}))
+		}
+	}
+
+	// SSV metrics reading '/v1/node/health' share a single poll of the endpoint per node
+	ssvHealthPollers := make([]*ssv.HealthPoller, len(configs.Values.Benchmark.SSV.Addresses))
+	for i, addr := range configs.Values.Benchmark.SSV.Addresses {
+		ssvHealthPollers[i] = ssv.NewHealthPoller(addr, time.Second*10)
+	}
+
+	if config.Benchmark.SSV.Metrics.Client.Enabled {
+		for i, addr := range configs.Values.Benchmark.SSV.Addresses {
+			enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)] = append(enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)],
+				ssv.NewClientMetric(
+					addr,
+					"Client",
+					time.Minute,
+					network.Name,
+					versionPolicy,
+					[]metric.HealthCondition[string]{
+						{Name: ssv.VersionMeasurement, Threshold: "", Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: ssv.VersionPolicyMeasurement, Threshold: string(version.StatusBlocked), Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: ssv.VersionPolicyMeasurement, Threshold: string(version.StatusOutdated), Operator: metric.OperatorEqual, Severity: metric.SeverityMedium},
+						{Name: ssv.VersionPolicyMeasurement, Threshold: string(version.StatusUnknown), Operator: metric.OperatorEqual, Severity: metric.SeverityLow},
+					}))
+		}
+	}
+	if config.Benchmark.SSV.Metrics.Subnets.Enabled {
+		for i, addr := range configs.Values.Benchmark.SSV.Addresses {
+			enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)] = append(enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)],
+				ssv.NewSubnetMetric(
+					addr,
+					"Subnets",
+					time.Second*30,
+					3,
+					[]metric.HealthCondition[float64]{
+						{Name: ssv.SubnetCoverageMeasurement, Threshold: 80, Operator: metric.OperatorLessThan, Severity: metric.SeverityHigh},
+						{Name: ssv.SubnetCoverageMeasurement, Threshold: 95, Operator: metric.OperatorLessThan, Severity: metric.SeverityMedium},
+						{Name: ssv.LowPeerSubnetsMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityLow},
+					}))
+		}
+	}
+	if config.Benchmark.SSV.Metrics.Health.Enabled {
+		for i := range configs.Values.Benchmark.SSV.Addresses {
+			enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)] = append(enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)],
+				ssv.NewHealthMetric(
+					ssvHealthPollers[i],
+					"Health",
+					time.Second*10,
+					[]metric.HealthCondition[uint32]{
+						{Name: ssv.BeaconNodeMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: ssv.ExecutionNodeMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: ssv.EventSyncerMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: ssv.P2PMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityMedium},
+						{Name: ssv.TransitionMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityLow},
+					}))
+		}
+	}
+	if config.Benchmark.SSV.Metrics.Peers.Enabled {
+		for i := range configs.Values.Benchmark.SSV.Addresses {
+			enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)] = append(enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)],
+				ssv.NewPeerMetric(
+					ssvHealthPollers[i],
+					"Peers",
+					time.Second*10,
+					[]metric.HealthCondition[uint32]{
+						{Name: ssv.PeerCountMeasurement, Threshold: 5, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: ssv.PeerCountMeasurement, Threshold: 10, Operator: metric.OperatorLessThanOrEqual, Severity: metric.SeverityMedium},
+					}))
+		}
+	}
 	if config.Benchmark.SSV.Metrics.Connections.Enabled {
-		enabledMetrics[metric.SSVGroup] = append(enabledMetrics[metric.SSVGroup], ssv.NewConnectionsMetric(
-			configs.Values.Benchmark.SSV.Address,
-			"Connections",
-			time.Second*10,
-			[]metric.HealthCondition[uint32]{
-				{Name: ssv.InboundConnectionsMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
-				{Name: ssv.OutboundConnectionsMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
-			}))
+		for i := range configs.Values.Benchmark.SSV.Addresses {
+			enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)] = append(enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)],
+				ssv.NewConnectionsMetric(
+					ssvHealthPollers[i],
+					"Connections",
+					time.Second*10,
+					[]metric.HealthCondition[uint32]{
+						{Name: ssv.InboundConnectionsMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+						{Name: ssv.OutboundConnectionsMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+					}))
+		}
+	}
+
+	if config.Benchmark.SSV.Metrics.Reachability.Enabled {
+		for i, addr := range configs.Values.Benchmark.SSV.Addresses {
+			host, err := config.Benchmark.SSV.P2P.Host(i, addr)
+			if err != nil {
+				return nil, errors.Join(err, errors.New("failed fetching SSV client P2P host"))
+			}
+			enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)] = append(enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)],
+				p2p.NewReachabilityMetric(
+					metric.SSVGroup,
+					host,
+					config.Benchmark.SSV.P2P.TCPPort,
+					config.Benchmark.SSV.P2P.UDPPort,
+					"Reachability",
+					time.Minute,
+					reachabilityConditions))
+		}
+	}
+
+	if config.Benchmark.SSV.Metrics.Scrape.Enabled && len(configs.Values.Benchmark.SSV.MetricsAddresses) != 0 {
+		var (
+			measurements     []ssv.ScrapeMeasurement
+			healthConditions []metric.HealthCondition[float64]
+		)
+		for _, m := range config.Benchmark.SSV.Scrape.Measurements {
+			measurement, err := ssv.NewScrapeMeasurement(m.Name, m.Type, m.Selector, m.Total)
+			if err != nil {
+				return nil, errors.Join(err, errors.New("failed loading SSV scrape measurements"))
+			}
+			measurements = append(measurements, measurement)
+			for _, condition := range m.Conditions {
+				healthConditions = append(healthConditions, metric.HealthCondition[float64]{
+					Name:      m.Name,
+					Threshold: condition.Threshold,
+					Operator:  metric.Operator(condition.Operator),
+					Severity:  metric.SeverityLevel(condition.Severity),
+				})
+			}
+		}
+
+		for i, addr := range configs.Values.Benchmark.SSV.MetricsAddresses {
+			enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)] = append(enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)],
+				ssv.NewScrapeMetric(
+					ssvAddress(configs.Values.Benchmark.SSV, i, addr),
+					addr,
+					"Scrape",
+					time.Second*30,
+					measurements,
+					healthConditions))
+		}
+	}
+
+	if config.Benchmark.SSV.Metrics.EventSyncer.Enabled && len(configs.Values.Benchmark.SSV.MetricsAddresses) == 0 {
+		slog.
+			With("metric_name", "EventSyncer").
+			Warn("SSV metrics address is not set, the event syncer lag will not be measured")
+	}
+	if config.Benchmark.SSV.Metrics.EventSyncer.Enabled && len(configs.Values.Benchmark.SSV.MetricsAddresses) != 0 && len(configs.Values.Benchmark.Execution.Addresses) != 0 {
+		selector, err := ssv.ParseSelector(config.Benchmark.SSV.Scrape.LastProcessedBlock)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed loading SSV last processed block selector"))
+		}
+		for i, addr := range configs.Values.Benchmark.SSV.MetricsAddresses {
+			enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)] = append(enabledMetrics[ssvGroup(configs.Values.Benchmark.SSV, i)],
+				ssv.NewEventSyncerMetric(
+					ssvAddress(configs.Values.Benchmark.SSV, i, addr),
+					addr,
+					configs.Values.Benchmark.Execution.Addresses,
+					"EventSyncer",
+					network.SlotDuration(),
+					selector,
+					[]metric.HealthCondition[float64]{
+						{Name: ssv.SyncerBlockLagMeasurement, Threshold: 64, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+						{Name: ssv.SyncerBlockLagMeasurement, Threshold: 16, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+						{Name: ssv.SyncerTimeLagMeasurement, Threshold: 900, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					}))
+		}
 	}
 
 	if config.Benchmark.Infrastructure.Metrics.CPU.Enabled {
@@ -144,5 +606,134 @@ func LoadEnabledMetrics(config configs.Config) (map[metric.Group][]metricService
 		)
 	}
 
+	if config.Benchmark.Infrastructure.Metrics.Disk.Enabled {
+		for _, path := range config.Benchmark.Infrastructure.DiskPaths {
+			enabledMetrics[metric.InfrastructureGroup] = append(enabledMetrics[metric.InfrastructureGroup],
+				infrastructure.NewDiskMetric(path, fmt.Sprintf("Disk (%s)", path), time.Minute, []metric.HealthCondition[float64]{
+					{Name: infrastructure.FreeSpaceMeasurement, Threshold: 10, Operator: metric.OperatorLessThan, Severity: metric.SeverityHigh},
+					{Name: infrastructure.FreeSpaceMeasurement, Threshold: 20, Operator: metric.OperatorLessThan, Severity: metric.SeverityLow},
+					{Name: infrastructure.FreeInodesMeasurement, Threshold: 10, Operator: metric.OperatorLessThan, Severity: metric.SeverityHigh},
+				}),
+			)
+		}
+	}
+
+	if config.Benchmark.Infrastructure.Metrics.DiskLatency.Enabled {
+		for _, path := range config.Benchmark.Infrastructure.DiskPaths {
+			enabledMetrics[metric.InfrastructureGroup] = append(enabledMetrics[metric.InfrastructureGroup],
+				infrastructure.NewDiskLatencyMetric(path, fmt.Sprintf("Disk Latency (%s)", path), time.Second*5, []metric.HealthCondition[time.Duration]{
+					{Name: infrastructure.FsyncP99Measurement, Threshold: time.Millisecond * 100, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					{Name: infrastructure.FsyncP99Measurement, Threshold: time.Millisecond * 20, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+				}),
+			)
+		}
+	}
+
+	if config.Benchmark.Infrastructure.Metrics.Network.Enabled {
+		ifaces := config.Benchmark.Infrastructure.NetworkInterfaces
+		if len(ifaces) == 0 {
+			ifaces, err = infrastructure.NetworkInterfaces()
+			if err != nil {
+				return nil, errors.Join(err, errors.New("failed listing network interfaces"))
+			}
+		}
+		slices.Sort(ifaces)
+		for _, iface := range ifaces {
+			enabledMetrics[metric.InfrastructureGroup] = append(enabledMetrics[metric.InfrastructureGroup],
+				infrastructure.NewNetworkMetric(iface, fmt.Sprintf("Network (%s)", iface), time.Second*5, []metric.HealthCondition[float64]{
+					{Name: infrastructure.UtilizationMeasurement, Threshold: 90, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					{Name: infrastructure.UtilizationMeasurement, Threshold: 70, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					{Name: infrastructure.ErrorsMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					{Name: infrastructure.DropsMeasurement, Threshold: 100, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityLow},
+				}),
+			)
+		}
+		enabledMetrics[metric.InfrastructureGroup] = append(enabledMetrics[metric.InfrastructureGroup],
+			infrastructure.NewTCPMetric("TCP", time.Second*5, []metric.HealthCondition[float64]{
+				{Name: infrastructure.RetransmitRateMeasurement, Threshold: 5, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+				{Name: infrastructure.RetransmitRateMeasurement, Threshold: 1, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+			}),
+		)
+	}
+
+	if config.Benchmark.Infrastructure.Metrics.Process.Enabled {
+		for _, process := range config.Benchmark.Infrastructure.Processes {
+			target, err := infrastructure.ParseProcessTarget(process)
+			if err != nil {
+				return nil, errors.Join(err, errors.New("failed loading infrastructure process targets"))
+			}
+			enabledMetrics[metric.InfrastructureGroup] = append(enabledMetrics[metric.InfrastructureGroup],
+				infrastructure.NewProcessMetric(target, fmt.Sprintf("Process (%s)", target.Label), time.Second*5, []metric.HealthCondition[float64]{
+					{Name: infrastructure.ProcessesMeasurement, Threshold: 0, Operator: metric.OperatorEqual, Severity: metric.SeverityHigh},
+					{Name: infrastructure.MemoryLimitMeasurement, Threshold: 90, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityHigh},
+					{Name: infrastructure.MemoryLimitMeasurement, Threshold: 80, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+					{Name: infrastructure.CPULimitMeasurement, Threshold: 90, Operator: metric.OperatorGreaterThanOrEqual, Severity: metric.SeverityMedium},
+				}),
+			)
+		}
+	}
+
+	// profiling is loaded last as it is triggered by the other metrics of the same SSV node and by the metrics shared by
+	// all nodes, e.g. the consensus client and the infrastructure ones
+	if config.Benchmark.SSV.Metrics.Profiling.Enabled {
+		names := make(map[string]bool)
+		for _, metrics := range enabledMetrics {
+			for _, m := range metrics {
+				names[m.GetName()] = true
+			}
+		}
+		for _, name := range config.Benchmark.SSV.Profiling.Metrics {
+			if !names[name] {
+				return nil, fmt.Errorf("SSV client profiling metric: '%s' was not an enabled metric", name)
+			}
+		}
+
+		for i, addr := range configs.Values.Benchmark.SSV.Profiling.Addresses {
+			group := ssvGroup(configs.Values.Benchmark.SSV, i)
+			profiler := ssv.NewProfileMetric(
+				addr,
+				"Profiling",
+				config.Benchmark.SSV.Profiling.OutputDirectory,
+				strings.ToLower(fmt.Sprintf("%s-%d", metric.SSVGroup, i+1)),
+				config.Benchmark.SSV.Profiling.CPUDuration,
+				config.Benchmark.SSV.Profiling.Cooldown)
+			for metricGroup, metrics := range enabledMetrics {
+				// the metrics of the other SSV nodes trigger their own profilers
+				if metricGroup != group && strings.HasPrefix(string(metricGroup), string(metric.SSVGroup)+"-") {
+					continue
+				}
+				for _, m := range metrics {
+					if n, ok := m.(severityNotifier); ok && slices.Contains(config.Benchmark.SSV.Profiling.Metrics, m.GetName()) {
+						n.OnSeverity(profiler.Trigger)
+					}
+				}
+			}
+			enabledMetrics[group] = append(enabledMetrics[group], profiler)
+		}
+	}
+
 	return enabledMetrics, nil
 }
+
+// severityNotifier is implemented by metrics embedding metric.Base
+type severityNotifier interface {
+	OnSeverity(hook metric.SeverityHook)
+}
+
+// ssvGroup returns the group of the i-th SSV node, e.g. 'SSV-1', or 'SSV-1 (operator-a)' when the node is named
+// ssvAddress returns the API address of the i-th SSV node, which labels the series of all its metrics. The metrics address
+// is returned when no API addresses are configured.
+func ssvAddress(ssvConfig configs.SSV, i int, metricsAddr string) string {
+	if i < len(ssvConfig.Addresses) {
+		return ssvConfig.Addresses[i]
+	}
+	return metricsAddr
+}
+
+func ssvGroup(ssvConfig configs.SSV, i int) metric.Group {
+	group := fmt.Sprintf("%s-%d", metric.SSVGroup, i+1)
+	if i < len(ssvConfig.Names) && ssvConfig.Names[i] != "" {
+		group = fmt.Sprintf("%s (%s)", group, ssvConfig.Names[i])
+	}
+	return metric.Group(group)
+}
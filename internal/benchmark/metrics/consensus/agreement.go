@@ -0,0 +1,304 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+const (
+	AgreementMeasurement            = "Agreement"
+	DisagreementMeasurement         = "Disagreement"
+	DisagreementRateMeasurement     = "DisagreementRate"
+	DisagreementDurationMeasurement = "DisagreementDuration"
+
+	headRootType        = "head_root"
+	attestationDataType = "attestation_data_root"
+	// agreementWindow is the number of latest compared slots the disagreement rates are calculated over, so that a single
+	// disagreement early in the run does not produce a rate of 100%
+	agreementWindow = 32
+)
+
+type (
+	nodeView struct {
+		headRoot, attestationDataRoot phase0.Root
+	}
+
+	slotComparison struct {
+		compared, outliers []string
+	}
+
+	// AgreementMetric compares, slot by slot, the head block root and the attestation data root served by every configured
+	// Consensus client. Both are fetched from all nodes at the attestation deadline of the same slot. Nodes holding a view
+	// different from the majority are reported as outliers. On a tie, the view of the first configured node wins, so that
+	// a fallback node diverging from the primary one is reported as the outlier. The disagreement rates are calculated over
+	// the latest compared slots once a full window is available.
+	AgreementMetric struct {
+		metric.Base[float64]
+		urls               []string
+		network            network.Network
+		mu                 sync.Mutex
+		outliers           map[string]uint32
+		window             []slotComparison
+		disagreementStreak uint32
+		longestStreak      uint32
+	}
+)
+
+func NewAgreementMetric(urls []string, name string, network network.Network, healthCondition []metric.HealthCondition[float64]) *AgreementMetric {
+	return &AgreementMetric{
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		urls:     urls,
+		network:  network,
+		outliers: make(map[string]uint32),
+	}
+}
+
+func (a *AgreementMetric) Measure(ctx context.Context) {
+	for {
+		slot := currentSlot(a.network) + 1
+		attestationDeadline := time.After(time.Until(slotTime(a.network, slot).Add(a.network.SlotDuration() / 3)))
+		select {
+		case <-attestationDeadline:
+			a.measure(ctx, slot)
+		case <-ctx.Done():
+			slog.With("metric_name", a.Name).Debug("metric was stopped")
+			return
+		}
+	}
+}
+
+func (a *AgreementMetric) measure(ctx context.Context, slot phase0.Slot) {
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		views = make(map[string]nodeView, len(a.urls))
+	)
+
+	for _, url := range a.urls {
+		wg.Add(1)
+		go func(url string) {
+			defer wg.Done()
+			view, err := fetchNodeView(ctx, url, slot)
+			if err != nil {
+				logger.WriteError(metric.ConsensusGroup, a.Name, errors.Join(err, fmt.Errorf("failed fetching view of node: '%s'", url)))
+				return
+			}
+			mu.Lock()
+			views[url] = view
+			mu.Unlock()
+		}(url)
+	}
+	wg.Wait()
+
+	if len(views) < 2 {
+		return
+	}
+
+	headOutliers := a.findOutliers(views, func(v nodeView) phase0.Root { return v.headRoot })
+	attestationOutliers := a.findOutliers(views, func(v nodeView) phase0.Root { return v.attestationDataRoot })
+
+	a.writeMetric(slot, slices.Sorted(maps.Keys(views)), headOutliers, attestationOutliers)
+}
+
+func fetchNodeView(ctx context.Context, url string, slot phase0.Slot) (nodeView, error) {
+	var (
+		headResp struct {
+			Data struct {
+				Root phase0.Root `json:"root"`
+			} `json:"data"`
+		}
+		attestationResp struct {
+			Data *phase0.AttestationData `json:"data"`
+		}
+	)
+
+	if err := fetch(ctx, fmt.Sprintf("%s/eth/v1/beacon/blocks/head/root", url), &headResp); err != nil {
+		return nodeView{}, errors.Join(err, errors.New("failed fetching head block root"))
+	}
+	if err := fetch(ctx, fmt.Sprintf("%s/eth/v1/validator/attestation_data?slot=%d&committee_index=0", url, slot), &attestationResp); err != nil {
+		return nodeView{}, errors.Join(err, errors.New("failed fetching attestation data"))
+	}
+	if attestationResp.Data == nil {
+		return nodeView{}, errors.New("attestation data response was empty")
+	}
+
+	attestationDataRoot, err := attestationResp.Data.HashTreeRoot()
+	if err != nil {
+		return nodeView{}, errors.Join(err, errors.New("failed calculating attestation data root"))
+	}
+
+	return nodeView{
+		headRoot:            headResp.Data.Root,
+		attestationDataRoot: attestationDataRoot,
+	}, nil
+}
+
+func (a *AgreementMetric) findOutliers(views map[string]nodeView, value func(nodeView) phase0.Root) []string {
+	votes := make(map[phase0.Root]int)
+	for _, view := range views {
+		votes[value(view)]++
+	}
+	if len(votes) == 1 {
+		return nil
+	}
+
+	var (
+		majority phase0.Root
+		maxVotes int
+		isTied   bool
+	)
+	for root, count := range votes {
+		if count > maxVotes {
+			majority, maxVotes, isTied = root, count, false
+		} else if count == maxVotes {
+			isTied = true
+		}
+	}
+	if isTied {
+		for _, url := range a.urls {
+			if view, ok := views[url]; ok && votes[value(view)] == maxVotes {
+				majority = value(view)
+				break
+			}
+		}
+	}
+
+	var outliers []string
+	for _, url := range a.urls {
+		if view, ok := views[url]; ok && value(view) != majority {
+			outliers = append(outliers, url)
+		}
+	}
+
+	return outliers
+}
+
+func (a *AgreementMetric) writeMetric(slot phase0.Slot, urls, headOutliers, attestationOutliers []string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, url := range headOutliers {
+		disagreementsMetric.With(disagreementLabels(url, headRootType)).Inc()
+	}
+	for _, url := range attestationOutliers {
+		disagreementsMetric.With(disagreementLabels(url, attestationDataType)).Inc()
+	}
+
+	outliers := slices.Compact(slices.Sorted(slices.Values(slices.Concat(headOutliers, attestationOutliers))))
+	for _, url := range outliers {
+		a.outliers[url]++
+	}
+	a.window = append(a.window, slotComparison{compared: urls, outliers: outliers})
+	if len(a.window) > agreementWindow {
+		a.window = a.window[len(a.window)-agreementWindow:]
+	}
+
+	if len(outliers) == 0 {
+		if a.disagreementStreak != 0 {
+			duration := time.Duration(a.disagreementStreak) * a.network.SlotDuration()
+			a.AddDataPoint(map[string]float64{
+				DisagreementDurationMeasurement: duration.Seconds(),
+			})
+			disagreementDurationMetric.Observe(duration.Seconds())
+			a.disagreementStreak = 0
+		}
+		a.AddDataPoint(map[string]float64{
+			AgreementMeasurement: 1,
+		})
+	} else {
+		a.disagreementStreak++
+		a.longestStreak = max(a.longestStreak, a.disagreementStreak)
+		a.AddDataPoint(map[string]float64{
+			DisagreementMeasurement: 1,
+		})
+	}
+
+	measurements := make(map[string]any)
+	if len(a.window) == agreementWindow {
+		disagreementRate, nodeRates := a.windowRates()
+		for url, rate := range nodeRates {
+			disagreementRateMetric.With(serverAddrLabel(url)).Set(rate)
+		}
+		a.AddDataPoint(map[string]float64{
+			DisagreementRateMeasurement: disagreementRate,
+		})
+		measurements[DisagreementRateMeasurement] = disagreementRate
+	}
+
+	logger.WriteMetric(metric.ConsensusGroup, a.Name, measurements, map[string]any{
+		"slot":                 slot,
+		"head_outliers":        headOutliers,
+		"attestation_outliers": attestationOutliers,
+	})
+}
+
+// windowRates returns the percentage of slots in the window with any outlier and, per node, the percentage of the slots
+// the node was compared in where it was an outlier
+func (a *AgreementMetric) windowRates() (float64, map[string]float64) {
+	var (
+		disagreements float64
+		compared      = make(map[string]float64)
+		outliers      = make(map[string]float64)
+	)
+	for _, comparison := range a.window {
+		if len(comparison.outliers) != 0 {
+			disagreements++
+		}
+		for _, url := range comparison.compared {
+			compared[url]++
+		}
+		for _, url := range comparison.outliers {
+			outliers[url]++
+		}
+	}
+
+	nodeRates := make(map[string]float64, len(compared))
+	for url, count := range compared {
+		nodeRates[url] = outliers[url] / count * 100
+	}
+
+	return disagreements / float64(len(a.window)) * 100, nodeRates
+}
+
+func (a *AgreementMetric) AggregateResults() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var agreements, disagreements, disagreementRate float64
+	for _, point := range a.DataPoints {
+		agreements += point.Values[AgreementMeasurement]
+		disagreements += point.Values[DisagreementMeasurement]
+	}
+	if compared := agreements + disagreements; compared != 0 {
+		disagreementRate = disagreements / compared * 100
+	}
+
+	var outliers []string
+	for _, url := range slices.Sorted(maps.Keys(a.outliers)) {
+		outliers = append(outliers, fmt.Sprintf("%s=%d", url, a.outliers[url]))
+	}
+
+	return fmt.Sprintf(
+		"agreed_slots=%.0f, disagreed_slots=%.0f, disagreement_rate=%.2f %% \n longest_disagreement=%s, outliers=[%s]",
+		agreements,
+		disagreements,
+		disagreementRate,
+		time.Duration(a.longestStreak)*a.network.SlotDuration(),
+		strings.Join(outliers, ", "))
+}
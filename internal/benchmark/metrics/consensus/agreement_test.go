@@ -0,0 +1,85 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+func TestAgreementMetric_FindOutliers(t *testing.T) {
+	var (
+		rootA = phase0.Root{0x0a}
+		rootB = phase0.Root{0x0b}
+		urls  = []string{"http://primary", "http://fallback", "http://third"}
+	)
+
+	tests := []struct {
+		name     string
+		views    map[string]nodeView
+		expected []string
+	}{
+		{
+			name: "All nodes agree",
+			views: map[string]nodeView{
+				"http://primary":  {headRoot: rootA},
+				"http://fallback": {headRoot: rootA},
+				"http://third":    {headRoot: rootA},
+			},
+			expected: nil,
+		},
+		{
+			name: "Majority wins",
+			views: map[string]nodeView{
+				"http://primary":  {headRoot: rootB},
+				"http://fallback": {headRoot: rootA},
+				"http://third":    {headRoot: rootA},
+			},
+			expected: []string{"http://primary"},
+		},
+		{
+			name: "Primary wins on tie",
+			views: map[string]nodeView{
+				"http://primary":  {headRoot: rootA},
+				"http://fallback": {headRoot: rootB},
+			},
+			expected: []string{"http://fallback"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metric := NewAgreementMetric(urls, "Agreement", network.Supported[network.Mainnet], nil)
+			actual := metric.findOutliers(tt.views, func(v nodeView) phase0.Root { return v.headRoot })
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestAgreementMetric_WindowedRate(t *testing.T) {
+	urls := []string{"http://primary", "http://fallback"}
+	metric := NewAgreementMetric(urls, "Agreement", network.Supported[network.Mainnet], nil)
+
+	metric.writeMetric(1, urls, []string{"http://fallback"}, nil)
+	for slot := phase0.Slot(2); slot < agreementWindow; slot++ {
+		metric.writeMetric(slot, urls, nil, nil)
+	}
+	assert.Empty(t, rates(metric))
+
+	metric.writeMetric(agreementWindow, urls, nil, nil)
+	metric.writeMetric(agreementWindow+1, urls, nil, nil)
+
+	assert.Equal(t, []float64{100.0 / agreementWindow, 0}, rates(metric))
+}
+
+func rates(metric *AgreementMetric) []float64 {
+	var rates []float64
+	for _, point := range metric.DataPoints {
+		if rate, ok := point.Values[DisagreementRateMeasurement]; ok {
+			rates = append(rates, rate)
+		}
+	}
+	return rates
+}
@@ -16,10 +16,10 @@ import (
 
 	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
 	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
 )
 
 const (
-	blockMintingTime             = time.Second * 12
 	unreadyBlockDelay            = time.Millisecond * 200
 	MissedBlockMeasurement       = "MissedBlock"
 	ReceivedBlockMeasurement     = "ReceivedBlock"
@@ -41,13 +41,13 @@ type (
 	AttestationMetric struct {
 		metric.Base[float64]
 		client                client.Service
-		genesisTime           time.Time
+		network               network.Network
 		eventBlockRoots       sync.Map
 		attestationBlockRoots sync.Map
 	}
 )
 
-func NewAttestationMetric(addr, name string, genesisTime time.Time, healthCondition []metric.HealthCondition[float64]) *AttestationMetric {
+func NewAttestationMetric(addr, name string, network network.Network, healthCondition []metric.HealthCondition[float64]) *AttestationMetric {
 	client, err := http.New(
 		context.TODO(),
 		http.WithLogLevel(zerolog.DebugLevel),
@@ -68,7 +68,7 @@ func NewAttestationMetric(addr, name string, genesisTime time.Time, healthCondit
 		client:                client,
 		eventBlockRoots:       sync.Map{},
 		attestationBlockRoots: sync.Map{},
-		genesisTime:           genesisTime,
+		network:               network,
 	}
 }
 
@@ -76,11 +76,11 @@ func (a *AttestationMetric) Measure(ctx context.Context) {
 	go a.launchListener(ctx)
 
 	go func() {
-		genesisSlot := currentSlot(a.genesisTime)
+		genesisSlot := currentSlot(a.network)
 		slot := genesisSlot
 		for {
 			slot++
-			nextSlotWithDelay := time.After(time.Until(slotTime(a.genesisTime, slot).Add(time.Second * 4)))
+			nextSlotWithDelay := time.After(time.Until(slotTime(a.network, slot).Add(a.network.SlotDuration() / 3)))
 			select {
 			case <-nextSlotWithDelay:
 				go func(slot phase0.Slot) {
@@ -271,14 +271,6 @@ func (a *AttestationMetric) calculateCorrectness() {
 	}, a.consensusClientLoggerArgs())
 }
 
-func slotTime(genesisTime time.Time, slot phase0.Slot) time.Time {
-	return genesisTime.Add(time.Duration(slot) * 12 * time.Second)
-}
-
-func currentSlot(genesisTime time.Time) phase0.Slot {
-	return phase0.Slot(time.Since(genesisTime) / (12 * time.Second))
-}
-
 func (a *AttestationMetric) consensusClientLoggerArgs() map[string]any {
 	return map[string]any{
 		"client_addr":   a.client.Address(),
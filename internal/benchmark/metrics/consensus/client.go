@@ -4,32 +4,62 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
 	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
 )
 
 const (
-	VersionMeasurement = "Version"
+	VersionMeasurement       = "Version"
+	VersionPolicyMeasurement = "VersionPolicy"
 )
 
 type ClientMetric struct {
 	metric.Base[string]
-	url string
+	url      string
+	interval time.Duration
+	network  network.Name
+	policy   version.Policy
 }
 
-func NewClientMetric(url, name string, healthCondition []metric.HealthCondition[string]) *ClientMetric {
+func NewClientMetric(url, name string, interval time.Duration, network network.Name, policy version.Policy, healthCondition []metric.HealthCondition[string]) *ClientMetric {
 	return &ClientMetric{
 		url: url,
 		Base: metric.Base[string]{
 			HealthConditions: healthCondition,
 			Name:             name,
 		},
+		interval: interval,
+		network:  network,
+		policy:   policy,
 	}
 }
 
 func (c *ClientMetric) Measure(ctx context.Context) {
+	c.measure(ctx)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", c.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			c.measure(ctx)
+		}
+	}
+}
+
+func (c *ClientMetric) measure(ctx context.Context) {
 	var (
 		resp struct {
 			Data struct {
@@ -37,20 +67,23 @@ func (c *ClientMetric) Measure(ctx context.Context) {
 			} `json:"data"`
 		}
 	)
-	res, err := http.Get(fmt.Sprintf("%s/eth/v1/node/version", c.url))
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/eth/v1/node/version", c.url), nil)
+	if err != nil {
+		logger.WriteError(metric.ConsensusGroup, c.Name, err)
+		return
+	}
+	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		c.AddDataPoint(map[string]string{
-			VersionMeasurement: "",
-		})
+		c.writeMetric("", version.StatusUnknown)
 		logger.WriteError(metric.ConsensusGroup, c.Name, err)
 		return
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		c.AddDataPoint(map[string]string{
-			VersionMeasurement: "",
-		})
+		c.writeMetric("", version.StatusUnknown)
 		var errorResponse any
 		_ = json.NewDecoder(res.Body).Decode(&errorResponse)
 		jsonErrResponse, _ := json.Marshal(errorResponse)
@@ -62,23 +95,60 @@ func (c *ClientMetric) Measure(ctx context.Context) {
 	}
 
 	if err = json.NewDecoder(res.Body).Decode(&resp); err != nil {
-		c.AddDataPoint(map[string]string{
-			VersionMeasurement: "",
-		})
+		c.writeMetric("", version.StatusUnknown)
 		logger.WriteError(metric.ConsensusGroup, c.Name, err)
 		return
 	}
 
+	info, err := version.Parse(resp.Data.Version)
+	if err != nil {
+		c.writeMetric(resp.Data.Version, version.StatusUnknown)
+		logger.WriteError(metric.ConsensusGroup, c.Name, err)
+		return
+	}
+
+	c.writeMetric(resp.Data.Version, c.policy.Check(info, string(c.network)))
+}
+
+func (c *ClientMetric) writeMetric(rawVersion string, status version.Status) {
 	c.AddDataPoint(map[string]string{
-		VersionMeasurement: resp.Data.Version,
+		VersionMeasurement:       rawVersion,
+		VersionPolicyMeasurement: string(status),
 	})
 
-	logger.WriteMetric(metric.ConsensusGroup, c.Name, map[string]any{VersionMeasurement: resp.Data.Version})
+	if rawVersion != "" {
+		// the series of the previous version or status would otherwise keep reporting 1 after an upgrade
+		clientVersionMetric.DeletePartialMatch(serverAddrLabel(c.url))
+		clientVersionMetric.With(clientVersionLabels(c.url, rawVersion, status)).Set(1)
+	}
+
+	logger.WriteMetric(metric.ConsensusGroup, c.Name, map[string]any{
+		VersionMeasurement:       rawVersion,
+		VersionPolicyMeasurement: status,
+	})
 }
 
 func (c *ClientMetric) AggregateResults() string {
-	if len(c.DataPoints) != 0 {
-		return c.DataPoints[0].Values[VersionMeasurement]
+	var (
+		versions []string
+		latest   map[string]string
+	)
+	for _, point := range c.DataPoints {
+		if v := point.Values[VersionMeasurement]; v != "" {
+			latest = point.Values
+			if !slices.Contains(versions, v) {
+				versions = append(versions, v)
+			}
+		}
+	}
+	if len(versions) == 0 {
+		return ""
 	}
-	return ""
+
+	result := fmt.Sprintf("%s, policy=%s", latest[VersionMeasurement], latest[VersionPolicyMeasurement])
+	if len(versions) > 1 {
+		result += fmt.Sprintf(" \n observed_versions=[%s]", strings.Join(versions, ", "))
+	}
+
+	return result
 }
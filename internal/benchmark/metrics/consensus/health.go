@@ -0,0 +1,149 @@
+package consensus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	StatusCodeMeasurement = "StatusCode"
+	TransitionMeasurement = "Transition"
+
+	// UnreachableStatusCode is recorded when the health endpoint could not be reached
+	UnreachableStatusCode = 0
+)
+
+type (
+	healthTransition struct {
+		at       time.Time
+		from, to uint32
+	}
+
+	// HealthMetric polls '/eth/v1/node/health', which responds with 200 when the node is ready,
+	// 206 when it is syncing and 503 when it is not initialized or having issues.
+	HealthMetric struct {
+		metric.Base[uint32]
+		url         string
+		interval    time.Duration
+		mu          sync.Mutex
+		transitions []healthTransition
+	}
+)
+
+func NewHealthMetric(url, name string, interval time.Duration, healthCondition []metric.HealthCondition[uint32]) *HealthMetric {
+	return &HealthMetric{
+		url: url,
+		Base: metric.Base[uint32]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (h *HealthMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", h.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			h.measure(ctx)
+		}
+	}
+}
+
+func (h *HealthMetric) measure(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/eth/v1/node/health", h.url), nil)
+	if err != nil {
+		logger.WriteError(metric.ConsensusGroup, h.Name, err)
+		return
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		h.writeMetric(UnreachableStatusCode)
+		logger.WriteError(metric.ConsensusGroup, h.Name, err)
+		return
+	}
+	defer res.Body.Close()
+
+	h.writeMetric(uint32(res.StatusCode))
+}
+
+func (h *HealthMetric) writeMetric(statusCode uint32) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	values := map[string]uint32{
+		StatusCodeMeasurement: statusCode,
+	}
+
+	if len(h.DataPoints) != 0 {
+		previous := h.DataPoints[len(h.DataPoints)-1].Values[StatusCodeMeasurement]
+		if previous != statusCode {
+			values[TransitionMeasurement] = 1
+			h.transitions = append(h.transitions, healthTransition{at: time.Now(), from: previous, to: statusCode})
+			nodeHealthTransitionsMetric.With(serverAddrLabel(h.url)).Inc()
+		}
+	}
+
+	h.AddDataPoint(values)
+
+	nodeHealthMetric.With(serverAddrLabel(h.url)).Set(float64(statusCode))
+
+	logger.WriteMetric(metric.ConsensusGroup, h.Name, map[string]any{
+		StatusCodeMeasurement: statusCode,
+	})
+}
+
+func (h *HealthMetric) AggregateResults() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.DataPoints) == 0 {
+		return ""
+	}
+
+	var ready, syncing, unavailable float64
+	for _, point := range h.DataPoints {
+		switch point.Values[StatusCodeMeasurement] {
+		case http.StatusOK:
+			ready++
+		case http.StatusPartialContent:
+			syncing++
+		default:
+			unavailable++
+		}
+	}
+	total := float64(len(h.DataPoints))
+
+	result := fmt.Sprintf("ready=%.2f%%, syncing=%.2f%%, unavailable=%.2f%%, transitions=%d",
+		ready/total*100,
+		syncing/total*100,
+		unavailable/total*100,
+		len(h.transitions))
+
+	if len(h.transitions) != 0 {
+		var transitions []string
+		// only the most recent transitions are listed to keep the report readable
+		for _, t := range h.transitions[max(0, len(h.transitions)-3):] {
+			transitions = append(transitions, fmt.Sprintf("%s: %d->%d", t.at.Format(time.TimeOnly), t.from, t.to))
+		}
+		result += fmt.Sprintf(" \n last_transitions=[%s]", strings.Join(transitions, ", "))
+	}
+
+	return result
+}
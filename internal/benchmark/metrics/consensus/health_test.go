@@ -0,0 +1,32 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthMetric_RecordsTransitions(t *testing.T) {
+	statusCodes := []int{http.StatusOK, http.StatusPartialContent, http.StatusPartialContent, http.StatusOK}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/node/health", r.URL.Path)
+		w.WriteHeader(statusCodes[0])
+		statusCodes = statusCodes[1:]
+	}))
+	defer server.Close()
+
+	metric := NewHealthMetric(server.URL, "Health", 0, nil)
+	for range 4 {
+		metric.measure(context.Background())
+	}
+
+	require.Len(t, metric.DataPoints, 4)
+	require.Len(t, metric.transitions, 2)
+	assert.Equal(t, healthTransition{at: metric.transitions[0].at, from: http.StatusOK, to: http.StatusPartialContent}, metric.transitions[0])
+	assert.Equal(t, healthTransition{at: metric.transitions[1].at, from: http.StatusPartialContent, to: http.StatusOK}, metric.transitions[1])
+	assert.Contains(t, metric.AggregateResults(), "ready=50.00%, syncing=50.00%, unavailable=0.00%, transitions=2")
+}
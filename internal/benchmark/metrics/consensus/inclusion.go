@@ -0,0 +1,434 @@
+package consensus
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+const (
+	InclusionDistanceMeasurement        = "InclusionDistance"
+	AverageInclusionDistanceMeasurement = "AverageInclusionDistance"
+	NotIncludedMeasurement              = "NotIncluded"
+)
+
+type (
+	attesterDuty struct {
+		validator               phase0.ValidatorIndex
+		slot                    phase0.Slot
+		committeeIndex          phase0.CommitteeIndex
+		validatorCommitteeIndex uint64
+	}
+
+	blockAttestation struct {
+		slot            phase0.Slot
+		committeeIndex  phase0.CommitteeIndex
+		aggregationBits []byte
+		// committeeBits is only set for attestations of Electra and later forks, where a single attestation aggregates
+		// multiple committees and aggregation bits of all of them are concatenated
+		committeeBits []byte
+	}
+
+	// InclusionMetric measures how many slots it takes for attestations of the configured validators to be included
+	// on-chain. It fetches attester duties every epoch and scans every block for aggregation bits covering the duties.
+	// Since Deneb (EIP-7045), attestations can be included until the end of the epoch following their own, attestations
+	// not found by then are reported as never included.
+	InclusionMetric struct {
+		metric.Base[float64]
+		urls             []string
+		network          network.Network
+		validators       []phase0.ValidatorIndex
+		mu               sync.Mutex
+		firstSlot        phase0.Slot
+		dutyEpochs       map[phase0.Epoch]bool
+		pending          map[phase0.Slot][]attesterDuty
+		committeeLengths map[phase0.Slot]map[phase0.CommitteeIndex]uint64
+		distances        map[uint64]uint64
+		notIncluded      uint64
+	}
+)
+
+func NewInclusionMetric(urls []string, name string, network network.Network, validators []phase0.ValidatorIndex, healthCondition []metric.HealthCondition[float64]) *InclusionMetric {
+	return &InclusionMetric{
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		urls:             urls,
+		network:          network,
+		validators:       validators,
+		dutyEpochs:       make(map[phase0.Epoch]bool),
+		pending:          make(map[phase0.Slot][]attesterDuty),
+		committeeLengths: make(map[phase0.Slot]map[phase0.CommitteeIndex]uint64),
+		distances:        make(map[uint64]uint64),
+	}
+}
+
+func (i *InclusionMetric) Measure(ctx context.Context) {
+	i.firstSlot = currentSlot(i.network) + 1
+
+	for {
+		slot := currentSlot(i.network) + 1
+		// blocks of the previous slot are scanned a third into the slot, so that late blocks are not treated as missing
+		blockDeadline := time.After(time.Until(slotTime(i.network, slot).Add(i.network.SlotDuration() / 3)))
+		select {
+		case <-blockDeadline:
+			i.measure(ctx, slot)
+		case <-ctx.Done():
+			slog.With("metric_name", i.Name).Debug("metric was stopped")
+			return
+		}
+	}
+}
+
+func (i *InclusionMetric) measure(ctx context.Context, slot phase0.Slot) {
+	epoch := slotEpoch(i.network, slot)
+	for _, e := range []phase0.Epoch{epoch, epoch + 1} {
+		if i.dutyEpochs[e] {
+			continue
+		}
+		if err := i.fetchDuties(ctx, e); err != nil {
+			logger.WriteError(metric.ValidatorGroup, i.Name, errors.Join(err, fmt.Errorf("failed fetching attester duties of epoch: %d", e)))
+			continue
+		}
+		i.dutyEpochs[e] = true
+	}
+
+	if slot == 0 {
+		return
+	}
+	blockSlot := slot - 1
+
+	attestations, err := i.fetchBlockAttestations(ctx, blockSlot)
+	if err != nil && !errors.Is(err, errNotFound) {
+		logger.WriteError(metric.ValidatorGroup, i.Name, errors.Join(err, fmt.Errorf("failed fetching block of slot: %d", blockSlot)))
+	}
+
+	for _, attestation := range attestations {
+		i.processAttestation(ctx, blockSlot, attestation)
+	}
+
+	i.expireDuties(blockSlot)
+}
+
+func (i *InclusionMetric) fetchDuties(ctx context.Context, epoch phase0.Epoch) error {
+	var resp struct {
+		Data []struct {
+			ValidatorIndex          string `json:"validator_index"`
+			CommitteeIndex          string `json:"committee_index"`
+			ValidatorCommitteeIndex string `json:"validator_committee_index"`
+			Slot                    string `json:"slot"`
+		} `json:"data"`
+	}
+
+	path := fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch)
+	if err := postWithFallback(ctx, i.urls, path, validatorIndicesAsStrings(i.validators), &resp); err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, d := range resp.Data {
+		duty, err := parseAttesterDuty(d.ValidatorIndex, d.CommitteeIndex, d.ValidatorCommitteeIndex, d.Slot)
+		if err != nil {
+			return err
+		}
+		// blocks before the metric was started are not scanned
+		if duty.slot < i.firstSlot {
+			continue
+		}
+		i.pending[duty.slot] = append(i.pending[duty.slot], duty)
+	}
+
+	return nil
+}
+
+func parseAttesterDuty(validatorIndex, committeeIndex, validatorCommitteeIndex, slot string) (attesterDuty, error) {
+	var (
+		values [4]uint64
+		err    error
+	)
+	for n, value := range []string{validatorIndex, committeeIndex, validatorCommitteeIndex, slot} {
+		values[n], err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return attesterDuty{}, errors.Join(err, errors.New("failed parsing attester duty"))
+		}
+	}
+
+	return attesterDuty{
+		validator:               phase0.ValidatorIndex(values[0]),
+		committeeIndex:          phase0.CommitteeIndex(values[1]),
+		validatorCommitteeIndex: values[2],
+		slot:                    phase0.Slot(values[3]),
+	}, nil
+}
+
+func (i *InclusionMetric) fetchBlockAttestations(ctx context.Context, slot phase0.Slot) ([]blockAttestation, error) {
+	var resp struct {
+		Data struct {
+			Message struct {
+				Body struct {
+					Attestations []struct {
+						AggregationBits string `json:"aggregation_bits"`
+						CommitteeBits   string `json:"committee_bits"`
+						Data            struct {
+							Slot  string `json:"slot"`
+							Index string `json:"index"`
+						} `json:"data"`
+					} `json:"attestations"`
+				} `json:"body"`
+			} `json:"message"`
+		} `json:"data"`
+	}
+
+	if err := fetchWithFallback(ctx, i.urls, fmt.Sprintf("/eth/v2/beacon/blocks/%d", slot), &resp); err != nil {
+		return nil, err
+	}
+
+	var attestations []blockAttestation
+	for _, a := range resp.Data.Message.Body.Attestations {
+		attestationSlot, err := strconv.ParseUint(a.Data.Slot, 10, 64)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed parsing attestation slot"))
+		}
+		committeeIndex, err := strconv.ParseUint(a.Data.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed parsing attestation committee index"))
+		}
+		aggregationBits, err := decodeBits(a.AggregationBits)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed decoding aggregation bits"))
+		}
+		committeeBits, err := decodeBits(a.CommitteeBits)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed decoding committee bits"))
+		}
+
+		attestations = append(attestations, blockAttestation{
+			slot:            phase0.Slot(attestationSlot),
+			committeeIndex:  phase0.CommitteeIndex(committeeIndex),
+			aggregationBits: aggregationBits,
+			committeeBits:   committeeBits,
+		})
+	}
+
+	return attestations, nil
+}
+
+func (i *InclusionMetric) fetchCommitteeLengths(ctx context.Context, slot phase0.Slot) (map[phase0.CommitteeIndex]uint64, error) {
+	i.mu.Lock()
+	lengths, ok := i.committeeLengths[slot]
+	i.mu.Unlock()
+	if ok {
+		return lengths, nil
+	}
+
+	var resp struct {
+		Data []struct {
+			Index      string   `json:"index"`
+			Validators []string `json:"validators"`
+		} `json:"data"`
+	}
+	path := fmt.Sprintf("/eth/v1/beacon/states/head/committees?slot=%d", slot)
+	if err := fetchWithFallback(ctx, i.urls, path, &resp); err != nil {
+		return nil, err
+	}
+
+	lengths = make(map[phase0.CommitteeIndex]uint64, len(resp.Data))
+	for _, committee := range resp.Data {
+		index, err := strconv.ParseUint(committee.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed parsing committee index"))
+		}
+		lengths[phase0.CommitteeIndex(index)] = uint64(len(committee.Validators))
+	}
+
+	i.mu.Lock()
+	i.committeeLengths[slot] = lengths
+	i.mu.Unlock()
+
+	return lengths, nil
+}
+
+func (i *InclusionMetric) processAttestation(ctx context.Context, blockSlot phase0.Slot, attestation blockAttestation) {
+	i.mu.Lock()
+	duties := i.pending[attestation.slot]
+	i.mu.Unlock()
+	if len(duties) == 0 {
+		return
+	}
+
+	var committeeLengths map[phase0.CommitteeIndex]uint64
+	if attestation.committeeBits != nil {
+		lengths, err := i.fetchCommitteeLengths(ctx, attestation.slot)
+		if err != nil {
+			logger.WriteError(metric.ValidatorGroup, i.Name, errors.Join(err, fmt.Errorf("failed fetching committees of slot: %d", attestation.slot)))
+			return
+		}
+		committeeLengths = lengths
+	}
+
+	var remaining []attesterDuty
+	for _, duty := range duties {
+		if attestation.covers(duty, committeeLengths) {
+			i.writeMetric(duty, uint64(blockSlot-attestation.slot))
+			continue
+		}
+		remaining = append(remaining, duty)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if len(remaining) == 0 {
+		delete(i.pending, attestation.slot)
+		delete(i.committeeLengths, attestation.slot)
+		return
+	}
+	i.pending[attestation.slot] = remaining
+}
+
+// covers reports whether the aggregation bits include the validator of the duty
+func (a blockAttestation) covers(duty attesterDuty, committeeLengths map[phase0.CommitteeIndex]uint64) bool {
+	if a.committeeBits == nil {
+		return a.committeeIndex == duty.committeeIndex && isBitSet(a.aggregationBits, duty.validatorCommitteeIndex)
+	}
+
+	if !isBitSet(a.committeeBits, uint64(duty.committeeIndex)) {
+		return false
+	}
+
+	var offset uint64
+	for committeeIndex := range phase0.CommitteeIndex(len(a.committeeBits) * 8) {
+		if committeeIndex == duty.committeeIndex {
+			break
+		}
+		if isBitSet(a.committeeBits, uint64(committeeIndex)) {
+			offset += committeeLengths[committeeIndex]
+		}
+	}
+
+	return isBitSet(a.aggregationBits, offset+duty.validatorCommitteeIndex)
+}
+
+func (i *InclusionMetric) expireDuties(blockSlot phase0.Slot) {
+	i.mu.Lock()
+	var expired []attesterDuty
+	for slot, duties := range i.pending {
+		if blockSlot >= epochStartSlot(i.network, slotEpoch(i.network, slot)+2) {
+			expired = append(expired, duties...)
+			delete(i.pending, slot)
+			delete(i.committeeLengths, slot)
+		}
+	}
+	i.mu.Unlock()
+
+	for _, duty := range expired {
+		i.writeNotIncluded(duty)
+	}
+}
+
+func (i *InclusionMetric) writeMetric(duty attesterDuty, distance uint64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.distances[distance]++
+
+	var included, distanceSum uint64
+	for d, count := range i.distances {
+		included += count
+		distanceSum += d * count
+	}
+	average := float64(distanceSum) / float64(included)
+
+	i.AddDataPoint(map[string]float64{
+		InclusionDistanceMeasurement:        float64(distance),
+		AverageInclusionDistanceMeasurement: average,
+	})
+
+	inclusionDistanceMetric.Observe(float64(distance))
+
+	logger.WriteMetric(metric.ValidatorGroup, i.Name, map[string]any{
+		InclusionDistanceMeasurement:        distance,
+		AverageInclusionDistanceMeasurement: average,
+	}, map[string]any{
+		"validator_index": duty.validator,
+		"slot":            duty.slot,
+	})
+}
+
+func (i *InclusionMetric) writeNotIncluded(duty attesterDuty) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.notIncluded++
+
+	i.AddDataPoint(map[string]float64{
+		NotIncludedMeasurement: 1,
+	})
+
+	notIncludedAttestationsMetric.With(validatorLabel(strconv.FormatUint(uint64(duty.validator), 10))).Inc()
+
+	logger.WriteMetric(metric.ValidatorGroup, i.Name, map[string]any{
+		NotIncludedMeasurement: 1,
+	}, map[string]any{
+		"validator_index": duty.validator,
+		"slot":            duty.slot,
+	})
+}
+
+func (i *InclusionMetric) AggregateResults() string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var (
+		included, distanceSum uint64
+		distribution          []string
+	)
+	for _, distance := range slices.Sorted(maps.Keys(i.distances)) {
+		count := i.distances[distance]
+		included += count
+		distanceSum += distance * count
+		distribution = append(distribution, fmt.Sprintf("%d: %d", distance, count))
+	}
+
+	var average float64
+	if included != 0 {
+		average = float64(distanceSum) / float64(included)
+	}
+
+	return fmt.Sprintf("included=%d, not_included=%d, average_distance=%.2f \n distribution=[%s]",
+		included,
+		i.notIncluded,
+		average,
+		strings.Join(distribution, ", "))
+}
+
+func decodeBits(str string) ([]byte, error) {
+	if str == "" {
+		return nil, nil
+	}
+	return hex.DecodeString(strings.TrimPrefix(str, "0x"))
+}
+
+// isBitSet checks a bit of an SSZ bitlist or bitvector, where bits are ordered from the least significant bit of the first byte
+func isBitSet(bits []byte, index uint64) bool {
+	if index/8 >= uint64(len(bits)) {
+		return false
+	}
+	return bits[index/8]&(1<<(index%8)) != 0
+}
@@ -0,0 +1,73 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+func TestInclusionMetric_Measure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/validator/duties/attester/0":
+			_, _ = w.Write([]byte(`{"data":[
+				{"validator_index":"10","committee_index":"1","validator_committee_index":"3","slot":"5"},
+				{"validator_index":"20","committee_index":"0","validator_committee_index":"1","slot":"5"},
+				{"validator_index":"30","committee_index":"0","validator_committee_index":"0","slot":"6"},
+				{"validator_index":"40","committee_index":"0","validator_committee_index":"2","slot":"6"}
+			]}`))
+		case "/eth/v1/validator/duties/attester/1", "/eth/v1/validator/duties/attester/2", "/eth/v1/validator/duties/attester/3":
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		case "/eth/v1/beacon/states/head/committees":
+			_, _ = w.Write([]byte(`{"data":[
+				{"index":"0","slot":"5","validators":["1","2","3","4"]},
+				{"index":"1","slot":"5","validators":["5","6","7","8"]}
+			]}`))
+		case "/eth/v2/beacon/blocks/6":
+			// Electra attestation aggregating committees 0 and 1, validator 10 is the 8th bit of the concatenated bits
+			_, _ = w.Write([]byte(`{"version":"electra","data":{"message":{"slot":"6","body":{"attestations":[
+				{"aggregation_bits":"0x8001","committee_bits":"0x0300000000000000","data":{"slot":"5","index":"0"}}
+			]}}}}`))
+		case "/eth/v2/beacon/blocks/8":
+			// pre-Electra attestation of committee 0 including validator 20
+			_, _ = w.Write([]byte(`{"version":"deneb","data":{"message":{"slot":"8","body":{"attestations":[
+				{"aggregation_bits":"0x12","data":{"slot":"5","index":"0"}}
+			]}}}}`))
+		case "/eth/v2/beacon/blocks/50":
+			// late attestation of validator 30, still within the inclusion window ending with the next epoch
+			_, _ = w.Write([]byte(`{"version":"deneb","data":{"message":{"slot":"50","body":{"attestations":[
+				{"aggregation_bits":"0x11","data":{"slot":"6","index":"0"}}
+			]}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	metric := NewInclusionMetric([]string{server.URL}, "Inclusion", network.Supported[network.Mainnet], []phase0.ValidatorIndex{10, 20, 30, 40}, nil)
+	for slot := phase0.Slot(1); slot <= 65; slot++ {
+		metric.measure(context.Background(), slot)
+	}
+
+	assert.Equal(t, map[uint64]uint64{1: 1, 3: 1, 44: 1}, metric.distances)
+	assert.Equal(t, uint64(1), metric.notIncluded)
+	assert.Empty(t, metric.pending)
+	assert.Equal(t, "included=3, not_included=1, average_distance=16.00 \n distribution=[1: 1, 3: 1, 44: 1]", metric.AggregateResults())
+}
+
+func TestIsBitSet(t *testing.T) {
+	bits := []byte{0b00000101, 0b10000000}
+
+	assert.True(t, isBitSet(bits, 0))
+	assert.False(t, isBitSet(bits, 1))
+	assert.True(t, isBitSet(bits, 2))
+	assert.True(t, isBitSet(bits, 15))
+	assert.False(t, isBitSet(bits, 16))
+}
@@ -0,0 +1,157 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+const (
+	NetworkMismatchMeasurement = "Mismatch"
+	UnscheduledForkMeasurement = "UnscheduledFork"
+)
+
+// NetworkMetric verifies that the consensus client is on the expected network by comparing its fork schedule
+// and deposit contract with the expected network parameters. Upcoming forks missing from the fork schedule of the client
+// are reported separately, as the client will stop following the chain once the fork epoch is reached.
+type NetworkMetric struct {
+	metric.Base[uint32]
+	url         string
+	interval    time.Duration
+	expected    network.Network
+	mismatches  []string
+	unscheduled []string
+}
+
+func NewNetworkMetric(url, name string, interval time.Duration, expected network.Network, healthCondition []metric.HealthCondition[uint32]) *NetworkMetric {
+	return &NetworkMetric{
+		url: url,
+		Base: metric.Base[uint32]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+		expected: expected,
+	}
+}
+
+func (n *NetworkMetric) Measure(ctx context.Context) {
+	n.measure(ctx)
+
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", n.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			n.measure(ctx)
+		}
+	}
+}
+
+func (n *NetworkMetric) measure(ctx context.Context) {
+	var (
+		depositResp struct {
+			Data struct {
+				ChainID string `json:"chain_id"`
+				Address string `json:"address"`
+			} `json:"data"`
+		}
+		forkResp struct {
+			Data []struct {
+				CurrentVersion string `json:"current_version"`
+				Epoch          string `json:"epoch"`
+			} `json:"data"`
+		}
+		mismatches []string
+	)
+
+	if err := fetch(ctx, fmt.Sprintf("%s/eth/v1/config/deposit_contract", n.url), &depositResp); err != nil {
+		logger.WriteError(metric.ConsensusGroup, n.Name, errors.Join(err, errors.New("failed fetching deposit contract")))
+		return
+	}
+	if err := fetch(ctx, fmt.Sprintf("%s/eth/v1/config/fork_schedule", n.url), &forkResp); err != nil {
+		logger.WriteError(metric.ConsensusGroup, n.Name, errors.Join(err, errors.New("failed fetching fork schedule")))
+		return
+	}
+
+	if n.expected.ChainID != 0 && depositResp.Data.ChainID != strconv.FormatUint(n.expected.ChainID, 10) {
+		mismatches = append(mismatches, fmt.Sprintf("chain ID: expected '%d', got '%s'", n.expected.ChainID, depositResp.Data.ChainID))
+	}
+	if n.expected.DepositContract != "" && !strings.EqualFold(depositResp.Data.Address, n.expected.DepositContract) {
+		mismatches = append(mismatches, fmt.Sprintf("deposit contract: expected '%s', got '%s'", n.expected.DepositContract, depositResp.Data.Address))
+	}
+
+	scheduled := make(map[string]uint64, len(forkResp.Data))
+	for _, fork := range forkResp.Data {
+		epoch, err := strconv.ParseUint(fork.Epoch, 10, 64)
+		if err != nil {
+			logger.WriteError(metric.ConsensusGroup, n.Name, errors.Join(err, errors.New("failed parsing fork epoch")))
+			return
+		}
+		scheduled[strings.ToLower(fork.CurrentVersion)] = epoch
+	}
+
+	var unscheduled []string
+	epoch := uint64(currentEpoch(n.expected))
+	for _, fork := range n.expected.Forks {
+		scheduledEpoch, isScheduled := scheduled[strings.ToLower(fork.Version)]
+		if isScheduled && scheduledEpoch == fork.Epoch {
+			continue
+		}
+		if fork.Epoch > epoch {
+			unscheduled = append(unscheduled, fmt.Sprintf("%s at epoch %d", fork.Name, fork.Epoch))
+			continue
+		}
+		if isScheduled {
+			mismatches = append(mismatches, fmt.Sprintf("fork %s: expected epoch %d, got %d", fork.Name, fork.Epoch, scheduledEpoch))
+		} else {
+			mismatches = append(mismatches, fmt.Sprintf("fork %s with version %s is missing", fork.Name, fork.Version))
+		}
+	}
+
+	n.writeMetric(mismatches, unscheduled)
+}
+
+func (n *NetworkMetric) writeMetric(mismatches, unscheduled []string) {
+	n.mismatches = mismatches
+	n.unscheduled = unscheduled
+
+	n.AddDataPoint(map[string]uint32{
+		NetworkMismatchMeasurement: uint32(len(mismatches)),
+		UnscheduledForkMeasurement: uint32(len(unscheduled)),
+	})
+
+	networkMismatchMetric.With(serverAddrLabel(n.url)).Set(float64(len(mismatches)))
+	unscheduledForksMetric.With(serverAddrLabel(n.url)).Set(float64(len(unscheduled)))
+
+	logger.WriteMetric(metric.ConsensusGroup, n.Name, map[string]any{
+		NetworkMismatchMeasurement: len(mismatches),
+		UnscheduledForkMeasurement: len(unscheduled),
+	}, map[string]any{
+		"mismatches":        strings.Join(mismatches, "; "),
+		"unscheduled_forks": strings.Join(unscheduled, "; "),
+	})
+}
+
+func (n *NetworkMetric) AggregateResults() string {
+	if len(n.DataPoints) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("network=%s, mismatches=[%s], unscheduled_forks=[%s]",
+		n.expected.Name,
+		strings.Join(n.mismatches, "; "),
+		strings.Join(n.unscheduled, "; "))
+}
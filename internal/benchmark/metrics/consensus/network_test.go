@@ -0,0 +1,58 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+func TestNetworkMetric_Measure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/config/deposit_contract":
+			_, _ = w.Write([]byte(`{"data":{"chain_id":"17000","address":"0x4242424242424242424242424242424242424242"}}`))
+		case "/eth/v1/config/fork_schedule":
+			_, _ = w.Write([]byte(`{"data":[
+				{"previous_version":"0x01017000","current_version":"0x01017000","epoch":"0"},
+				{"previous_version":"0x01017000","current_version":"0x02017000","epoch":"0"},
+				{"previous_version":"0x02017000","current_version":"0x03017000","epoch":"0"},
+				{"previous_version":"0x03017000","current_version":"0x04017000","epoch":"300"}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	expected := network.Supported[network.Hoodi]
+	// genesis is moved, so that the last fork of the expected network is still upcoming
+	expected.GenesisTime = time.Now().Add(-expected.EpochDuration() * 10)
+	expected.Forks = []network.Fork{
+		{Name: "phase0", Epoch: 0, Version: "0x01017000"},
+		{Name: "altair", Epoch: 0, Version: "0x02017000"},
+		{Name: "bellatrix", Epoch: 0, Version: "0x03017000"},
+		{Name: "capella", Epoch: 5, Version: "0x04017000"},
+		{Name: "deneb", Epoch: 20, Version: "0x05017000"},
+	}
+
+	metric := NewNetworkMetric(server.URL, "Network", time.Minute, expected, nil)
+	metric.measure(context.Background())
+
+	require.Len(t, metric.DataPoints, 1)
+	assert.Equal(t, uint32(3), metric.DataPoints[0].Values[NetworkMismatchMeasurement])
+	assert.Equal(t, uint32(1), metric.DataPoints[0].Values[UnscheduledForkMeasurement])
+	assert.Equal(t, []string{
+		"chain ID: expected '560048', got '17000'",
+		"deposit contract: expected '0x00000000219ab540356cBB839Cbe05303d7705Fa', got '0x4242424242424242424242424242424242424242'",
+		"fork capella: expected epoch 5, got 300",
+	}, metric.mismatches)
+	assert.Equal(t, []string{"deneb at epoch 20"}, metric.unscheduled)
+}
@@ -0,0 +1,57 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ExecutionPayload identifies the execution block referenced by a beacon block
+type ExecutionPayload struct {
+	BlockNumber uint64
+	BlockHash   string
+	Timestamp   uint64
+}
+
+// FetchExecutionPayload returns the execution payload of the beacon block with the given block ID, e.g. 'head' or a slot.
+// Consensus clients are queried in order and the first successful response is used.
+func FetchExecutionPayload(ctx context.Context, urls []string, blockID string) (ExecutionPayload, error) {
+	var resp struct {
+		Data struct {
+			Message struct {
+				Body struct {
+					ExecutionPayload *struct {
+						BlockNumber string `json:"block_number"`
+						BlockHash   string `json:"block_hash"`
+						Timestamp   string `json:"timestamp"`
+					} `json:"execution_payload"`
+				} `json:"body"`
+			} `json:"message"`
+		} `json:"data"`
+	}
+
+	if err := fetchWithFallback(ctx, urls, fmt.Sprintf("/eth/v2/beacon/blocks/%s", blockID), &resp); err != nil {
+		return ExecutionPayload{}, err
+	}
+
+	payload := resp.Data.Message.Body.ExecutionPayload
+	if payload == nil {
+		return ExecutionPayload{}, fmt.Errorf("beacon block: '%s' did not contain an execution payload", blockID)
+	}
+
+	blockNumber, err := strconv.ParseUint(payload.BlockNumber, 10, 64)
+	if err != nil {
+		return ExecutionPayload{}, errors.Join(err, errors.New("failed parsing execution payload block number"))
+	}
+	timestamp, err := strconv.ParseUint(payload.Timestamp, 10, 64)
+	if err != nil {
+		return ExecutionPayload{}, errors.Join(err, errors.New("failed parsing execution payload timestamp"))
+	}
+
+	return ExecutionPayload{
+		BlockNumber: blockNumber,
+		BlockHash:   payload.BlockHash,
+		Timestamp:   timestamp,
+	}, nil
+}
@@ -16,7 +16,14 @@ import (
 )
 
 const (
-	PeerCountMeasurement = "Count"
+	PeerCountMeasurement    = "Count"
+	DisconnectedMeasurement = "Disconnected"
+	ConnectingMeasurement   = "Connecting"
+	InboundMeasurement      = "Inbound"
+	OutboundMeasurement     = "Outbound"
+
+	inboundDirection  = "inbound"
+	outboundDirection = "outbound"
 )
 
 type PeerMetric struct {
@@ -55,7 +62,9 @@ func (p *PeerMetric) measure(ctx context.Context) {
 	var (
 		resp struct {
 			Data struct {
-				Connected string `json:"connected"`
+				Connected    string `json:"connected"`
+				Connecting   string `json:"connecting"`
+				Disconnected string `json:"disconnected"`
 			} `json:"data"`
 		}
 	)
@@ -101,7 +110,51 @@ func (p *PeerMetric) measure(ctx context.Context) {
 		return
 	}
 
-	p.writeMetric(peerNr)
+	values := map[string]uint32{
+		PeerCountMeasurement: uint32(peerNr),
+	}
+	// older clients may not report all the states, so missing ones are not measured instead of being reported as zero
+	if connecting, err := strconv.Atoi(resp.Data.Connecting); err == nil {
+		values[ConnectingMeasurement] = uint32(connecting)
+	}
+	if disconnected, err := strconv.Atoi(resp.Data.Disconnected); err == nil {
+		values[DisconnectedMeasurement] = uint32(disconnected)
+	}
+
+	// clients not reporting the peer direction would otherwise show up as having neither inbound nor outbound peers
+	inbound, outbound, err := p.fetchDirections(ctx)
+	if err != nil {
+		logger.WriteError(metric.ConsensusGroup, p.Name, errors.Join(err, errors.New("failed fetching peer directions")))
+	} else if inbound+outbound != 0 {
+		values[InboundMeasurement] = inbound
+		values[OutboundMeasurement] = outbound
+	}
+
+	p.writeMetric(values)
+}
+
+func (p *PeerMetric) fetchDirections(ctx context.Context) (inbound, outbound uint32, err error) {
+	var (
+		resp struct {
+			Data []struct {
+				Direction string `json:"direction"`
+			} `json:"data"`
+		}
+	)
+	if err := fetch(ctx, fmt.Sprintf("%s/eth/v1/node/peers?state=connected", p.url), &resp); err != nil {
+		return 0, 0, err
+	}
+
+	for _, peer := range resp.Data {
+		switch peer.Direction {
+		case inboundDirection:
+			inbound++
+		case outboundDirection:
+			outbound++
+		}
+	}
+
+	return inbound, outbound, nil
 }
 
 func (p *PeerMetric) logErrorResponse(res *http.Response) {
@@ -142,28 +195,57 @@ func (p *PeerMetric) logErrorResponse(res *http.Response) {
 		fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, responseString))
 }
 
-func (p *PeerMetric) writeMetric(peerNr int) {
-	p.AddDataPoint(map[string]uint32{
-		PeerCountMeasurement: uint32(peerNr),
-	})
+func (p *PeerMetric) writeMetric(values map[string]uint32) {
+	p.AddDataPoint(values)
 
-	peerCountMetric.With(serverAddrLabel(p.url)).Set(float64(peerNr))
+	peerCountMetric.With(serverAddrLabel(p.url)).Set(float64(values[PeerCountMeasurement]))
+	for measurement, state := range map[string]string{ConnectingMeasurement: "connecting", DisconnectedMeasurement: "disconnected"} {
+		if value, ok := values[measurement]; ok {
+			peerStateMetric.With(peerStateLabels(p.url, state)).Set(float64(value))
+		}
+	}
+	for measurement, direction := range map[string]string{InboundMeasurement: inboundDirection, OutboundMeasurement: outboundDirection} {
+		if value, ok := values[measurement]; ok {
+			peerDirectionMetric.With(peerDirectionLabels(p.url, direction)).Set(float64(value))
+		}
+	}
 
-	logger.WriteMetric(metric.ConsensusGroup, p.Name, map[string]any{PeerCountMeasurement: peerNr})
+	loggerValues := make(map[string]any, len(values))
+	for name, value := range values {
+		loggerValues[name] = value
+	}
+	logger.WriteMetric(metric.ConsensusGroup, p.Name, loggerValues)
 }
 
 func (p *PeerMetric) AggregateResults() string {
-	var values []uint32
+	var values = make(map[string][]uint32)
 	for _, point := range p.DataPoints {
-		values = append(values, point.Values[PeerCountMeasurement])
+		for name, value := range point.Values {
+			values[name] = append(values[name], value)
+		}
 	}
 
-	percentiles := metric.CalculatePercentiles(values, 0, 10, 50, 90, 100)
+	percentiles := metric.CalculatePercentiles(values[PeerCountMeasurement], 0, 10, 50, 90, 100)
 
-	return metric.FormatPercentiles(
+	result := metric.FormatPercentiles(
 		percentiles[0],
 		percentiles[10],
 		percentiles[50],
 		percentiles[90],
 		percentiles[100])
+
+	if len(values[InboundMeasurement]) != 0 {
+		result += fmt.Sprintf(" \n inbound_min=%d, inbound_P50=%d, outbound_min=%d, outbound_P50=%d",
+			metric.CalculatePercentiles(values[InboundMeasurement], 0)[0],
+			metric.CalculatePercentiles(values[InboundMeasurement], 50)[50],
+			metric.CalculatePercentiles(values[OutboundMeasurement], 0)[0],
+			metric.CalculatePercentiles(values[OutboundMeasurement], 50)[50])
+	}
+	if len(values[ConnectingMeasurement]) != 0 || len(values[DisconnectedMeasurement]) != 0 {
+		result += fmt.Sprintf(" \n connecting_P50=%d, disconnected_P50=%d",
+			metric.CalculatePercentiles(values[ConnectingMeasurement], 50)[50],
+			metric.CalculatePercentiles(values[DisconnectedMeasurement], 50)[50])
+	}
+
+	return result
 }
@@ -0,0 +1,53 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerMetric_Directions(t *testing.T) {
+	tests := []struct {
+		name     string
+		peers    string
+		expected map[string]uint32
+	}{
+		{
+			name:     "Directions reported",
+			peers:    `{"data":[{"direction":"inbound"},{"direction":"outbound"},{"direction":"outbound"}]}`,
+			expected: map[string]uint32{PeerCountMeasurement: 3, InboundMeasurement: 1, OutboundMeasurement: 2},
+		},
+		{
+			name:     "Directions not reported",
+			peers:    `{"data":[{"direction":""},{"direction":""},{"direction":""}]}`,
+			expected: map[string]uint32{PeerCountMeasurement: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/eth/v1/node/peer_count":
+					_, _ = w.Write([]byte(`{"data":{"connected":"3"}}`))
+				case "/eth/v1/node/peers":
+					_, _ = w.Write([]byte(tt.peers))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			metric := NewPeerMetric(server.URL, "Peers", 0, nil)
+			metric.measure(context.Background())
+
+			require.Len(t, metric.DataPoints, 1)
+			assert.Equal(t, tt.expected, metric.DataPoints[0].Values)
+		})
+	}
+}
@@ -0,0 +1,409 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+const (
+	HeadCorrectnessMeasurement    = "HeadCorrectness"
+	TargetCorrectnessMeasurement  = "TargetCorrectness"
+	SourceCorrectnessMeasurement  = "SourceCorrectness"
+	MissedAttestationsMeasurement = "MissedAttestations"
+	LateAttestationsMeasurement   = "LateAttestations"
+	InactiveValidatorsMeasurement = "InactiveValidators"
+	OfflineValidatorsMeasurement  = "OfflineValidators"
+	RewardsMeasurement            = "Rewards"
+	BalanceDeltaMeasurement       = "BalanceDelta"
+
+	// epochProcessingDelay gives the Consensus client time to process the epoch transition before it is queried
+	epochProcessingDelay = 2
+)
+
+type (
+	attestationRewards struct {
+		head, target, source, inactivity int64
+	}
+
+	validatorPerformance struct {
+		epochs, headCorrect, targetCorrect, sourceCorrect uint64
+		missed, late, inactive, offline                   uint64
+		rewards                                           int64
+		initialBalance, balance                           uint64
+	}
+
+	// PerformanceMetric tracks the on-chain outcome of the configured validators. Every epoch it checks the attestation
+	// rewards of the epoch before the previous one (the latest epoch whose attestations can no longer be included),
+	// the liveness of the previous epoch and the current balances.
+	PerformanceMetric struct {
+		metric.Base[float64]
+		urls         []string
+		network      network.Network
+		validators   []phase0.ValidatorIndex
+		mu           sync.Mutex
+		performances map[phase0.ValidatorIndex]*validatorPerformance
+	}
+)
+
+func NewPerformanceMetric(urls []string, name string, network network.Network, validators []phase0.ValidatorIndex, healthCondition []metric.HealthCondition[float64]) *PerformanceMetric {
+	performances := make(map[phase0.ValidatorIndex]*validatorPerformance, len(validators))
+	for _, validator := range validators {
+		performances[validator] = &validatorPerformance{}
+	}
+
+	return &PerformanceMetric{
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		urls:         urls,
+		network:      network,
+		validators:   validators,
+		performances: performances,
+	}
+}
+
+func (p *PerformanceMetric) Measure(ctx context.Context) {
+	for {
+		epoch := currentEpoch(p.network) + 1
+		epochStart := time.After(time.Until(slotTime(p.network, epochStartSlot(p.network, epoch)+epochProcessingDelay)))
+		select {
+		case <-epochStart:
+			p.measure(ctx, epoch)
+		case <-ctx.Done():
+			slog.With("metric_name", p.Name).Debug("metric was stopped")
+			return
+		}
+	}
+}
+
+func (p *PerformanceMetric) measure(ctx context.Context, epoch phase0.Epoch) {
+	values := make(map[string]float64)
+
+	if epoch >= 2 {
+		rewards, err := p.fetchAttestationRewards(ctx, epoch-2)
+		if err != nil {
+			logger.WriteError(metric.ValidatorGroup, p.Name, errors.Join(err, fmt.Errorf("failed fetching attestation rewards of epoch: %d", epoch-2)))
+		} else {
+			p.recordRewards(rewards, values)
+		}
+	}
+
+	if epoch >= 1 {
+		liveness, err := p.fetchLiveness(ctx, epoch-1)
+		if err != nil {
+			logger.WriteError(metric.ValidatorGroup, p.Name, errors.Join(err, fmt.Errorf("failed fetching liveness of epoch: %d", epoch-1)))
+		} else {
+			p.recordLiveness(liveness, values)
+		}
+	}
+
+	balances, err := p.fetchBalances(ctx)
+	if err != nil {
+		logger.WriteError(metric.ValidatorGroup, p.Name, errors.Join(err, errors.New("failed fetching validator balances")))
+	} else {
+		p.recordBalances(balances, values)
+	}
+
+	if len(values) == 0 {
+		return
+	}
+
+	p.AddDataPoint(values)
+
+	loggerValues := make(map[string]any, len(values))
+	for name, value := range values {
+		loggerValues[name] = value
+	}
+	logger.WriteMetric(metric.ValidatorGroup, p.Name, loggerValues, map[string]any{"epoch": epoch})
+}
+
+func (p *PerformanceMetric) fetchAttestationRewards(ctx context.Context, epoch phase0.Epoch) (map[phase0.ValidatorIndex]attestationRewards, error) {
+	var resp struct {
+		Data struct {
+			TotalRewards []struct {
+				ValidatorIndex string `json:"validator_index"`
+				Head           string `json:"head"`
+				Target         string `json:"target"`
+				Source         string `json:"source"`
+				Inactivity     string `json:"inactivity"`
+			} `json:"total_rewards"`
+		} `json:"data"`
+	}
+
+	path := fmt.Sprintf("/eth/v1/beacon/rewards/attestations/%d", epoch)
+	if err := postWithFallback(ctx, p.urls, path, validatorIndicesAsStrings(p.validators), &resp); err != nil {
+		return nil, err
+	}
+
+	rewards := make(map[phase0.ValidatorIndex]attestationRewards, len(resp.Data.TotalRewards))
+	for _, reward := range resp.Data.TotalRewards {
+		index, err := strconv.ParseUint(reward.ValidatorIndex, 10, 64)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed parsing validator index"))
+		}
+
+		parsed, err := parseAttestationRewards(reward.Head, reward.Target, reward.Source, reward.Inactivity)
+		if err != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed parsing rewards of validator: %d", index))
+		}
+		rewards[phase0.ValidatorIndex(index)] = parsed
+	}
+
+	return rewards, nil
+}
+
+func parseAttestationRewards(head, target, source, inactivity string) (attestationRewards, error) {
+	var (
+		rewards attestationRewards
+		err     error
+	)
+	parse := func(value string) int64 {
+		// some clients omit rewards that do not apply, e.g. inactivity outside of an inactivity leak
+		if value == "" || err != nil {
+			return 0
+		}
+		var parsed int64
+		parsed, err = strconv.ParseInt(value, 10, 64)
+		return parsed
+	}
+
+	rewards.head = parse(head)
+	rewards.target = parse(target)
+	rewards.source = parse(source)
+	rewards.inactivity = parse(inactivity)
+
+	return rewards, err
+}
+
+func (p *PerformanceMetric) fetchLiveness(ctx context.Context, epoch phase0.Epoch) (map[phase0.ValidatorIndex]bool, error) {
+	var resp struct {
+		Data []struct {
+			Index  string `json:"index"`
+			IsLive bool   `json:"is_live"`
+		} `json:"data"`
+	}
+
+	path := fmt.Sprintf("/eth/v1/validator/liveness/%d", epoch)
+	if err := postWithFallback(ctx, p.urls, path, validatorIndicesAsStrings(p.validators), &resp); err != nil {
+		return nil, err
+	}
+
+	liveness := make(map[phase0.ValidatorIndex]bool, len(resp.Data))
+	for _, v := range resp.Data {
+		index, err := strconv.ParseUint(v.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed parsing validator index"))
+		}
+		liveness[phase0.ValidatorIndex(index)] = v.IsLive
+	}
+
+	return liveness, nil
+}
+
+func (p *PerformanceMetric) fetchBalances(ctx context.Context) (map[phase0.ValidatorIndex]uint64, error) {
+	balances := make(map[phase0.ValidatorIndex]uint64, len(p.validators))
+	for chunk := range slices.Chunk(validatorIndicesAsStrings(p.validators), validatorIDsPerRequest) {
+		var resp struct {
+			Data []struct {
+				Index   string `json:"index"`
+				Balance string `json:"balance"`
+			} `json:"data"`
+		}
+
+		path := fmt.Sprintf("/eth/v1/beacon/states/head/validator_balances?id=%s", strings.Join(chunk, ","))
+		if err := fetchWithFallback(ctx, p.urls, path, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, v := range resp.Data {
+			index, err := strconv.ParseUint(v.Index, 10, 64)
+			if err != nil {
+				return nil, errors.Join(err, errors.New("failed parsing validator index"))
+			}
+			balance, err := strconv.ParseUint(v.Balance, 10, 64)
+			if err != nil {
+				return nil, errors.Join(err, fmt.Errorf("failed parsing balance of validator: %d", index))
+			}
+			balances[phase0.ValidatorIndex(index)] = balance
+		}
+	}
+
+	return balances, nil
+}
+
+func (p *PerformanceMetric) recordRewards(rewards map[phase0.ValidatorIndex]attestationRewards, values map[string]float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var headCorrect, targetCorrect, sourceCorrect, missed, late, inactive, total, rewardsSum float64
+	for index, reward := range rewards {
+		performance, ok := p.performances[index]
+		if !ok {
+			continue
+		}
+		label := strconv.FormatUint(uint64(index), 10)
+
+		// a validator that is not active in the epoch, e.g. pending activation or exited, is neither rewarded nor penalized
+		if reward == (attestationRewards{}) {
+			inactive++
+			performance.inactive++
+			continue
+		}
+		total++
+		performance.epochs++
+
+		if reward.head > 0 {
+			headCorrect++
+			performance.headCorrect++
+		}
+		if reward.target > 0 {
+			targetCorrect++
+			performance.targetCorrect++
+		}
+		// the source reward requires the attestation to be included within 5 slots, a target that was not penalized
+		// means it was still included later in the inclusion window
+		switch {
+		case reward.source > 0:
+			sourceCorrect++
+			performance.sourceCorrect++
+		case reward.target >= 0:
+			late++
+			performance.late++
+			validatorLateAttestationsMetric.With(validatorLabel(label)).Inc()
+		default:
+			missed++
+			performance.missed++
+			validatorMissedAttestationsMetric.With(validatorLabel(label)).Inc()
+		}
+
+		epochRewards := reward.head + reward.target + reward.source + reward.inactivity
+		performance.rewards += epochRewards
+		rewardsSum += float64(epochRewards)
+
+		validatorRewardsMetric.With(validatorTypeLabels(label, "head")).Set(float64(reward.head))
+		validatorRewardsMetric.With(validatorTypeLabels(label, "target")).Set(float64(reward.target))
+		validatorRewardsMetric.With(validatorTypeLabels(label, "source")).Set(float64(reward.source))
+		validatorRewardsMetric.With(validatorTypeLabels(label, "inactivity")).Set(float64(reward.inactivity))
+	}
+
+	if inactive != 0 {
+		values[InactiveValidatorsMeasurement] = inactive
+	}
+	if total == 0 {
+		return
+	}
+
+	values[HeadCorrectnessMeasurement] = headCorrect / total * 100
+	values[TargetCorrectnessMeasurement] = targetCorrect / total * 100
+	values[SourceCorrectnessMeasurement] = sourceCorrect / total * 100
+	values[MissedAttestationsMeasurement] = missed
+	values[LateAttestationsMeasurement] = late
+	values[RewardsMeasurement] = rewardsSum
+}
+
+func (p *PerformanceMetric) recordLiveness(liveness map[phase0.ValidatorIndex]bool, values map[string]float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var offline float64
+	for index, isLive := range liveness {
+		performance, ok := p.performances[index]
+		if !ok || isLive {
+			continue
+		}
+		offline++
+		performance.offline++
+	}
+
+	values[OfflineValidatorsMeasurement] = offline
+}
+
+func (p *PerformanceMetric) recordBalances(balances map[phase0.ValidatorIndex]uint64, values map[string]float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var delta float64
+	for index, balance := range balances {
+		performance, ok := p.performances[index]
+		if !ok {
+			continue
+		}
+		if performance.initialBalance == 0 {
+			performance.initialBalance = balance
+		} else {
+			delta += float64(balance) - float64(performance.balance)
+		}
+		performance.balance = balance
+
+		validatorBalanceMetric.With(validatorLabel(strconv.FormatUint(uint64(index), 10))).Set(float64(balance))
+	}
+
+	values[BalanceDeltaMeasurement] = delta
+}
+
+func (p *PerformanceMetric) AggregateResults() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var (
+		total      validatorPerformance
+		validators []string
+	)
+	for _, index := range p.validators {
+		performance := p.performances[index]
+		total.epochs += performance.epochs
+		total.headCorrect += performance.headCorrect
+		total.targetCorrect += performance.targetCorrect
+		total.sourceCorrect += performance.sourceCorrect
+		total.missed += performance.missed
+		total.late += performance.late
+		total.inactive += performance.inactive
+		total.offline += performance.offline
+		total.rewards += performance.rewards
+
+		validators = append(validators, fmt.Sprintf("%d: head=%.2f%%, target=%.2f%%, source=%.2f%%, missed=%d, late=%d, inactive_epochs=%d, offline_epochs=%d, rewards=%d gwei, balance_delta=%d gwei",
+			index,
+			percentage(performance.headCorrect, performance.epochs),
+			percentage(performance.targetCorrect, performance.epochs),
+			percentage(performance.sourceCorrect, performance.epochs),
+			performance.missed,
+			performance.late,
+			performance.inactive,
+			performance.offline,
+			performance.rewards,
+			int64(performance.balance)-int64(performance.initialBalance)))
+	}
+
+	return fmt.Sprintf("validators=%d, head=%.2f%%, target=%.2f%%, source=%.2f%%, missed=%d, late=%d, inactive_epochs=%d, offline_epochs=%d, rewards=%d gwei \n %s",
+		len(p.validators),
+		percentage(total.headCorrect, total.epochs),
+		percentage(total.targetCorrect, total.epochs),
+		percentage(total.sourceCorrect, total.epochs),
+		total.missed,
+		total.late,
+		total.inactive,
+		total.offline,
+		total.rewards,
+		strings.Join(validators, " \n "))
+}
+
+func percentage(part, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}
@@ -0,0 +1,70 @@
+package consensus
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+func TestPerformanceMetric_Measure(t *testing.T) {
+	balance := 32000000000
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/rewards/attestations/8":
+			var indices []string
+			if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&indices)) {
+				return
+			}
+			assert.Equal(t, []string{"1", "2", "3", "4"}, indices)
+			_, _ = w.Write([]byte(`{"data":{"total_rewards":[
+				{"validator_index":"1","head":"2000","target":"4000","source":"2000","inactivity":"0"},
+				{"validator_index":"2","head":"0","target":"-4000","source":"-2000"},
+				{"validator_index":"3","head":"0","target":"4000","source":"-2000","inactivity":"0"},
+				{"validator_index":"4","head":"0","target":"0","source":"0","inactivity":"0"}
+			]}}`))
+		case "/eth/v1/validator/liveness/9":
+			_, _ = w.Write([]byte(`{"data":[{"index":"1","is_live":true},{"index":"2","is_live":false},{"index":"3","is_live":true},{"index":"4","is_live":true}]}`))
+		case "/eth/v1/beacon/states/head/validator_balances":
+			assert.Equal(t, "1,2,3,4", r.URL.Query().Get("id"))
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": []map[string]string{
+				{"index": "1", "balance": strconv.Itoa(balance)},
+				{"index": "2", "balance": strconv.Itoa(balance)},
+			}})
+			balance += 1000
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	metric := NewPerformanceMetric([]string{server.URL}, "Performance", network.Supported[network.Mainnet], []phase0.ValidatorIndex{1, 2, 3, 4}, nil)
+	metric.measure(context.Background(), 10)
+	metric.measure(context.Background(), 10)
+
+	require.Len(t, metric.DataPoints, 2)
+	values := metric.DataPoints[0].Values
+	assert.InDelta(t, 100.0/3, values[HeadCorrectnessMeasurement], 0.01)
+	assert.InDelta(t, 200.0/3, values[TargetCorrectnessMeasurement], 0.01)
+	assert.InDelta(t, 100.0/3, values[SourceCorrectnessMeasurement], 0.01)
+	assert.Equal(t, 1.0, values[MissedAttestationsMeasurement])
+	assert.Equal(t, 1.0, values[LateAttestationsMeasurement])
+	assert.Equal(t, 1.0, values[InactiveValidatorsMeasurement])
+	assert.Equal(t, 1.0, values[OfflineValidatorsMeasurement])
+	assert.Equal(t, 4000.0, values[RewardsMeasurement])
+	assert.Equal(t, 2000.0, metric.DataPoints[1].Values[BalanceDeltaMeasurement])
+
+	assert.Equal(t, uint64(2), metric.performances[2].missed)
+	assert.Equal(t, uint64(2), metric.performances[3].late)
+	assert.Equal(t, uint64(2), metric.performances[4].inactive)
+	assert.Equal(t, int64(1000), int64(metric.performances[1].balance)-int64(metric.performances[1].initialBalance))
+}
@@ -3,6 +3,8 @@ package consensus
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
 )
 
 const (
@@ -10,6 +12,12 @@ const (
 	subsystem = "consensus"
 
 	serverAddrLabelName = "server_address"
+	typeLabelName       = "type"
+	versionLabelName    = "version"
+	statusLabelName     = "status"
+	stateLabelName      = "state"
+	directionLabelName  = "direction"
+	validatorLabelName  = "validator_index"
 )
 
 var (
@@ -23,6 +31,38 @@ var (
 			Subsystem: subsystem,
 		}, labels)
 
+	peerStateMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "peer_state_count",
+			Help:      "number of peers per connection state",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, stateLabelName})
+
+	peerDirectionMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "peer_direction_count",
+			Help:      "number of connected peers per connection direction",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, directionLabelName})
+
+	nodeHealthMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "node_health",
+			Help:      "HTTP status code returned by the node health endpoint",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	nodeHealthTransitionsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "node_health_transitions",
+			Help:      "number of node health status changes",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
 	latencyMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Name:      "latency",
 		Help:      "histogram of latencies for HTTP requests in seconds",
@@ -70,6 +110,136 @@ var (
 			Namespace: namespace,
 			Subsystem: subsystem,
 		}, labels)
+
+	clientVersionMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "client_version",
+			Help:      "client version reported by the node along with its version policy status",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, versionLabelName, statusLabelName})
+
+	networkMismatchMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "network_mismatches",
+			Help:      "number of network parameters of the node not matching the expected network",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	unscheduledForksMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "unscheduled_forks",
+			Help:      "number of upcoming forks of the expected network missing from the fork schedule of the node",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	validatorRewardsMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "validator_attestation_rewards",
+			Help:      "attestation rewards of the validator in the latest processed epoch in gwei",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{validatorLabelName, typeLabelName})
+
+	validatorMissedAttestationsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "validator_missed_attestations",
+			Help:      "number of attestations of the validator that were not included",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{validatorLabelName})
+
+	validatorLateAttestationsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "validator_late_attestations",
+			Help:      "number of attestations of the validator that were included too late for the source reward",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{validatorLabelName})
+
+	validatorBalanceMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "validator_balance",
+			Help:      "balance of the validator in gwei",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{validatorLabelName})
+
+	inclusionDistanceMetric = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:      "attestation_inclusion_distance",
+			Help:      "histogram of slots between the attestation slot and the slot of the block including it",
+			Buckets:   []float64{1, 2, 3, 4, 8, 16, 32},
+			Namespace: namespace,
+			Subsystem: subsystem,
+		})
+
+	notIncludedAttestationsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "validator_not_included_attestations",
+			Help:      "number of attestations of the validator that were not found in any block within the inclusion window",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{validatorLabelName})
+
+	nextProposalMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "validator_next_proposal_time",
+			Help:      "unix time of the latest known upcoming block proposal of the validator",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{validatorLabelName})
+
+	proposalsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "validator_proposals",
+			Help:      "number of block proposals of the validator per status",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{validatorLabelName, statusLabelName})
+
+	validatorSlashedMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "validator_slashed",
+			Help:      "set to 1 once the validator was found in a slashing",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{validatorLabelName, typeLabelName})
+
+	networkSlashingsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "network_slashed_validators",
+			Help:      "number of validators slashed network-wide during the run",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{typeLabelName})
+
+	disagreementsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "disagreements",
+			Help:      "number of slots in which the node disagreed with the majority of consensus clients",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, typeLabelName})
+
+	disagreementRateMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "disagreement_rate",
+			Help:      "percentage of the latest compared slots in which the node disagreed with the majority of consensus clients on the head block or attestation data",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName})
+
+	disagreementDurationMetric = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:      "disagreement_duration",
+			Help:      "histogram of disagreement durations between consensus clients in seconds",
+			Buckets:   []float64{6, 12, 24, 48, 96, 192, 384},
+			Namespace: namespace,
+			Subsystem: subsystem,
+		})
 )
 
 func serverAddrLabel(serverAddr string) map[string]string {
@@ -77,3 +247,58 @@ func serverAddrLabel(serverAddr string) map[string]string {
 		serverAddrLabelName: serverAddr,
 	}
 }
+
+func peerStateLabels(serverAddr, state string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		stateLabelName:      state,
+	}
+}
+
+func peerDirectionLabels(serverAddr, direction string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		directionLabelName:  direction,
+	}
+}
+
+func clientVersionLabels(serverAddr, version string, status version.Status) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		versionLabelName:    version,
+		statusLabelName:     string(status),
+	}
+}
+
+func typeLabel(labelType string) map[string]string {
+	return map[string]string{
+		typeLabelName: labelType,
+	}
+}
+
+func validatorLabel(validatorIndex string) map[string]string {
+	return map[string]string{
+		validatorLabelName: validatorIndex,
+	}
+}
+
+func validatorTypeLabels(validatorIndex, labelType string) map[string]string {
+	return map[string]string{
+		validatorLabelName: validatorIndex,
+		typeLabelName:      labelType,
+	}
+}
+
+func validatorStatusLabels(validatorIndex, status string) map[string]string {
+	return map[string]string{
+		validatorLabelName: validatorIndex,
+		statusLabelName:    status,
+	}
+}
+
+func disagreementLabels(serverAddr, disagreementType string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		typeLabelName:       disagreementType,
+	}
+}
@@ -0,0 +1,267 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+const (
+	UpcomingProposalMeasurement   = "UpcomingProposal"
+	SuccessfulProposalMeasurement = "SuccessfulProposal"
+	MissedProposalMeasurement     = "MissedProposal"
+
+	// lookaheadFork is the fork from which beacon nodes serve the proposer duties of the next epoch (EIP-7917)
+	lookaheadFork = "fulu"
+)
+
+type (
+	proposalStatus string
+
+	proposal struct {
+		validator phase0.ValidatorIndex
+		slot      phase0.Slot
+		status    proposalStatus
+	}
+
+	// ProposalMetric fetches proposer duties of the current epoch for the configured validators, and of the next epoch
+	// once the Fulu fork is active, reports upcoming proposals and, once a proposal slot has passed, checks whether
+	// a canonical block from the expected proposer exists. Proposals whose block could not be checked stay pending and
+	// are checked again on the next slot.
+	ProposalMetric struct {
+		metric.Base[float64]
+		urls       []string
+		network    network.Network
+		validators []phase0.ValidatorIndex
+		mu         sync.Mutex
+		dutyEpochs map[phase0.Epoch]bool
+		proposals  map[phase0.Slot]*proposal
+	}
+)
+
+const (
+	proposalUpcoming   proposalStatus = "upcoming"
+	proposalSuccessful proposalStatus = "successful"
+	proposalMissed     proposalStatus = "missed"
+)
+
+func NewProposalMetric(urls []string, name string, network network.Network, validators []phase0.ValidatorIndex, healthCondition []metric.HealthCondition[float64]) *ProposalMetric {
+	return &ProposalMetric{
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		urls:       urls,
+		network:    network,
+		validators: validators,
+		dutyEpochs: make(map[phase0.Epoch]bool),
+		proposals:  make(map[phase0.Slot]*proposal),
+	}
+}
+
+func (p *ProposalMetric) Measure(ctx context.Context) {
+	for {
+		slot := currentSlot(p.network) + 1
+		// the block of the previous slot is checked a third into the slot, so that late blocks are not reported as missed
+		blockDeadline := time.After(time.Until(slotTime(p.network, slot).Add(p.network.SlotDuration() / 3)))
+		select {
+		case <-blockDeadline:
+			p.measure(ctx, slot)
+		case <-ctx.Done():
+			slog.With("metric_name", p.Name).Debug("metric was stopped")
+			return
+		}
+	}
+}
+
+func (p *ProposalMetric) measure(ctx context.Context, slot phase0.Slot) {
+	epoch := slotEpoch(p.network, slot)
+	epochs := []phase0.Epoch{epoch}
+	if forkEpoch, ok := p.network.ForkEpoch(lookaheadFork); ok && uint64(epoch) >= forkEpoch {
+		epochs = append(epochs, epoch+1)
+	}
+	for _, e := range epochs {
+		if p.dutyEpochs[e] {
+			continue
+		}
+		if err := p.fetchDuties(ctx, e, slot); err != nil {
+			logger.WriteError(metric.ValidatorGroup, p.Name, errors.Join(err, fmt.Errorf("failed fetching proposer duties of epoch: %d", e)))
+			continue
+		}
+		p.dutyEpochs[e] = true
+	}
+
+	for _, proposalSlot := range p.pendingSlots(slot) {
+		p.checkProposal(ctx, proposalSlot)
+	}
+}
+
+// pendingSlots returns the slots before the given one whose proposals were not checked yet
+func (p *ProposalMetric) pendingSlots(slot phase0.Slot) []phase0.Slot {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var slots []phase0.Slot
+	for proposalSlot, proposal := range p.proposals {
+		if proposalSlot < slot && proposal.status == proposalUpcoming {
+			slots = append(slots, proposalSlot)
+		}
+	}
+	slices.Sort(slots)
+
+	return slots
+}
+
+func (p *ProposalMetric) fetchDuties(ctx context.Context, epoch phase0.Epoch, firstSlot phase0.Slot) error {
+	var resp struct {
+		Data []struct {
+			ValidatorIndex string `json:"validator_index"`
+			Slot           string `json:"slot"`
+		} `json:"data"`
+	}
+
+	if err := fetchWithFallback(ctx, p.urls, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), &resp); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, duty := range resp.Data {
+		index, err := strconv.ParseUint(duty.ValidatorIndex, 10, 64)
+		if err != nil {
+			return errors.Join(err, errors.New("failed parsing validator index"))
+		}
+		if !slices.Contains(p.validators, phase0.ValidatorIndex(index)) {
+			continue
+		}
+		slot, err := strconv.ParseUint(duty.Slot, 10, 64)
+		if err != nil {
+			return errors.Join(err, errors.New("failed parsing proposal slot"))
+		}
+		// the block of the slot preceding the first measured one is checked as well, earlier proposals are not
+		if phase0.Slot(slot)+1 < firstSlot {
+			continue
+		}
+		if _, ok := p.proposals[phase0.Slot(slot)]; ok {
+			continue
+		}
+
+		upcoming := &proposal{
+			validator: phase0.ValidatorIndex(index),
+			slot:      phase0.Slot(slot),
+			status:    proposalUpcoming,
+		}
+		p.proposals[upcoming.slot] = upcoming
+
+		proposalTime := slotTime(p.network, upcoming.slot)
+		p.AddDataPoint(map[string]float64{
+			UpcomingProposalMeasurement: 1,
+		})
+		nextProposalMetric.With(validatorLabel(duty.ValidatorIndex)).Set(float64(proposalTime.Unix()))
+
+		slog.
+			With("metric_name", p.Name).
+			With("validator_index", upcoming.validator).
+			With("slot", upcoming.slot).
+			With("proposal_time", proposalTime).
+			With("time_until_proposal", time.Until(proposalTime).Round(time.Second).String()).
+			Warn("upcoming block proposal")
+	}
+
+	return nil
+}
+
+func (p *ProposalMetric) checkProposal(ctx context.Context, slot phase0.Slot) {
+	p.mu.Lock()
+	expected, ok := p.proposals[slot]
+	p.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	var resp struct {
+		Data struct {
+			Canonical bool `json:"canonical"`
+			Header    struct {
+				Message struct {
+					ProposerIndex string `json:"proposer_index"`
+				} `json:"message"`
+			} `json:"header"`
+		} `json:"data"`
+	}
+
+	err := fetchWithFallback(ctx, p.urls, fmt.Sprintf("/eth/v1/beacon/headers/%d", slot), &resp)
+	if err != nil && !errors.Is(err, errNotFound) {
+		logger.WriteError(metric.ValidatorGroup, p.Name, errors.Join(err, fmt.Errorf("failed fetching block header of slot: %d, retrying on the next slot", slot)))
+		return
+	}
+
+	status := proposalMissed
+	if err == nil && resp.Data.Canonical && resp.Data.Header.Message.ProposerIndex == strconv.FormatUint(uint64(expected.validator), 10) {
+		status = proposalSuccessful
+	}
+
+	p.writeMetric(expected, status)
+}
+
+func (p *ProposalMetric) writeMetric(proposal *proposal, status proposalStatus) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	proposal.status = status
+
+	measurement := SuccessfulProposalMeasurement
+	if status == proposalMissed {
+		measurement = MissedProposalMeasurement
+	}
+
+	p.AddDataPoint(map[string]float64{
+		measurement: 1,
+	})
+
+	proposalsMetric.With(validatorStatusLabels(strconv.FormatUint(uint64(proposal.validator), 10), string(status))).Inc()
+
+	logger.WriteMetric(metric.ValidatorGroup, p.Name, map[string]any{
+		measurement: 1,
+	}, map[string]any{
+		"validator_index": proposal.validator,
+		"slot":            proposal.slot,
+		"slot_time":       slotTime(p.network, proposal.slot),
+	})
+}
+
+func (p *ProposalMetric) AggregateResults() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	byStatus := make(map[proposalStatus][]string)
+	for _, slot := range slices.Sorted(maps.Keys(p.proposals)) {
+		proposal := p.proposals[slot]
+		byStatus[proposal.status] = append(byStatus[proposal.status], fmt.Sprintf("%d@%d (%s)",
+			proposal.validator,
+			proposal.slot,
+			slotTime(p.network, proposal.slot).UTC().Format(time.DateTime)))
+	}
+
+	return fmt.Sprintf("upcoming=%d, successful=%d, missed=%d \n upcoming=[%s] \n successful=[%s] \n missed=[%s]",
+		len(byStatus[proposalUpcoming]),
+		len(byStatus[proposalSuccessful]),
+		len(byStatus[proposalMissed]),
+		strings.Join(byStatus[proposalUpcoming], ", "),
+		strings.Join(byStatus[proposalSuccessful], ", "),
+		strings.Join(byStatus[proposalMissed], ", "))
+}
@@ -0,0 +1,84 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+func TestProposalMetric_Measure(t *testing.T) {
+	headerFailures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/validator/duties/proposer/0":
+			_, _ = w.Write([]byte(`{"data":[
+				{"pubkey":"0x01","validator_index":"10","slot":"3"},
+				{"pubkey":"0x02","validator_index":"99","slot":"4"},
+				{"pubkey":"0x03","validator_index":"20","slot":"5"},
+				{"pubkey":"0x04","validator_index":"30","slot":"6"}
+			]}`))
+		case "/eth/v1/validator/duties/proposer/1":
+			_, _ = w.Write([]byte(`{"data":[{"pubkey":"0x01","validator_index":"10","slot":"40"}]}`))
+		case "/eth/v1/beacon/headers/3":
+			_, _ = w.Write([]byte(`{"data":{"root":"0x00","canonical":true,"header":{"message":{"slot":"3","proposer_index":"10"}}}}`))
+		case "/eth/v1/beacon/headers/5":
+			// a transient failure keeps the proposal pending until the next slot
+			if headerFailures > 0 {
+				headerFailures--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		case "/eth/v1/beacon/headers/6":
+			// block of a reorged proposal is not canonical anymore
+			_, _ = w.Write([]byte(`{"data":{"root":"0x00","canonical":false,"header":{"message":{"slot":"6","proposer_index":"30"}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	fulu := network.Supported[network.Mainnet]
+	fulu.Forks = []network.Fork{{Name: "fulu", Epoch: 0, Version: "0x06000000"}}
+	metric := NewProposalMetric([]string{server.URL}, "Proposal", fulu, []phase0.ValidatorIndex{10, 20, 30}, nil)
+	metric.measure(context.Background(), 1)
+	// the duties of the next epoch are fetched ahead once Fulu is active
+	require.Len(t, metric.proposals, 4)
+
+	for slot := phase0.Slot(2); slot <= 8; slot++ {
+		metric.measure(context.Background(), slot)
+	}
+
+	assert.Equal(t, proposalSuccessful, metric.proposals[3].status)
+	assert.Equal(t, proposalMissed, metric.proposals[5].status)
+	assert.Equal(t, proposalMissed, metric.proposals[6].status)
+	assert.Equal(t, proposalUpcoming, metric.proposals[40].status)
+	assert.Contains(t, metric.AggregateResults(), "upcoming=1, successful=1, missed=2")
+}
+
+func TestProposalMetric_NoLookaheadBeforeFulu(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/validator/duties/proposer/0":
+			_, _ = w.Write([]byte(`{"data":[{"pubkey":"0x01","validator_index":"10","slot":"3"}]}`))
+		default:
+			assert.Fail(t, "unexpected request", r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	metric := NewProposalMetric([]string{server.URL}, "Proposal", network.Supported[network.Mainnet], []phase0.ValidatorIndex{10}, nil)
+	metric.measure(context.Background(), 1)
+
+	assert.Len(t, metric.proposals, 1)
+}
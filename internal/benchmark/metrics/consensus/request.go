@@ -0,0 +1,101 @@
+package consensus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+const requestTimeout = 5 * time.Second
+
+// errNotFound is returned when the requested resource does not exist, e.g. a block of an empty slot
+var errNotFound = errors.New("resource not found")
+
+func fetch(ctx context.Context, url string, resp any) error {
+	return request(ctx, http.MethodGet, url, nil, resp)
+}
+
+func post(ctx context.Context, url string, body, resp any) error {
+	return request(ctx, http.MethodPost, url, body, resp)
+}
+
+// fetchWithFallback queries the Consensus clients in order and stops at the first successful response
+func fetchWithFallback(ctx context.Context, urls []string, path string, resp any) error {
+	return withFallback(urls, resp, func(url string, resp any) error {
+		return fetch(ctx, url+path, resp)
+	})
+}
+
+// postWithFallback queries the Consensus clients in order and stops at the first successful response
+func postWithFallback(ctx context.Context, urls []string, path string, body, resp any) error {
+	return withFallback(urls, resp, func(url string, resp any) error {
+		return post(ctx, url+path, body, resp)
+	})
+}
+
+// withFallback decodes every attempt into a fresh value, so that fields decoded from a failed response do not leak into
+// the response of the next client
+func withFallback(urls []string, resp any, call func(url string, resp any) error) error {
+	var (
+		callErr error
+		target  = reflect.ValueOf(resp).Elem()
+	)
+	for _, url := range urls {
+		attempt := reflect.New(target.Type())
+		err := call(url, attempt.Interface())
+		if err == nil {
+			target.Set(attempt.Elem())
+			return nil
+		}
+		callErr = errors.Join(callErr, fmt.Errorf("consensus client: '%s': %w", url, err))
+	}
+	if callErr == nil {
+		return errors.New("no consensus client address configured")
+	}
+	return callErr
+}
+
+func request(ctx context.Context, method, url string, body, resp any) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	var reqBody io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, resBody)
+	}
+
+	return json.NewDecoder(res.Body).Decode(resp)
+}
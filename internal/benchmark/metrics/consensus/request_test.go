@@ -0,0 +1,33 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchWithFallback_FreshResponsePerClient(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"root":"0x01","slot":`))
+	}))
+	defer failing.Close()
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"slot":"2"}}`))
+	}))
+	defer fallback.Close()
+
+	var resp struct {
+		Data struct {
+			Root string `json:"root"`
+			Slot string `json:"slot"`
+		} `json:"data"`
+	}
+	require.NoError(t, fetchWithFallback(context.Background(), []string{failing.URL, fallback.URL}, "/", &resp))
+
+	assert.Empty(t, resp.Data.Root)
+	assert.Equal(t, "2", resp.Data.Slot)
+}
@@ -0,0 +1,296 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	client "github.com/attestantio/go-eth2-client"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/http"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+const (
+	SlashedValidatorMeasurement = "SlashedValidator"
+	NetworkSlashingMeasurement  = "NetworkSlashing"
+
+	attesterSlashingTopic = "attester_slashing"
+	proposerSlashingTopic = "proposer_slashing"
+
+	slashingSourceEvent = "event"
+	slashingSourceBlock = "block"
+)
+
+type (
+	slashing struct {
+		slashingType string
+		slot         phase0.Slot
+		source       string
+		detected     time.Time
+	}
+
+	slashedAttestation struct {
+		AttestingIndices []string `json:"attesting_indices"`
+		Data             struct {
+			Slot string `json:"slot"`
+		} `json:"data"`
+	}
+
+	// SlashingMetric subscribes to the slashing topics of the beacon node event streams and, as a fallback for missed events,
+	// scans the contents of every block. Each slashed validator is recorded once, validators run by the cluster are reported separately.
+	SlashingMetric struct {
+		metric.Base[float64]
+		urls       []string
+		network    network.Network
+		validators []phase0.ValidatorIndex
+		mu         sync.Mutex
+		slashed    map[phase0.ValidatorIndex]slashing
+	}
+)
+
+func NewSlashingMetric(urls []string, name string, network network.Network, validators []phase0.ValidatorIndex, healthCondition []metric.HealthCondition[float64]) *SlashingMetric {
+	return &SlashingMetric{
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		urls:       urls,
+		network:    network,
+		validators: validators,
+		slashed:    make(map[phase0.ValidatorIndex]slashing),
+	}
+}
+
+func (s *SlashingMetric) Measure(ctx context.Context) {
+	for _, url := range s.urls {
+		go s.launchListener(ctx, url)
+	}
+
+	for {
+		slot := currentSlot(s.network) + 1
+		blockDeadline := time.After(time.Until(slotTime(s.network, slot).Add(s.network.SlotDuration() / 3)))
+		select {
+		case <-blockDeadline:
+			s.measure(ctx, slot)
+		case <-ctx.Done():
+			slog.With("metric_name", s.Name).Debug("metric was stopped")
+			return
+		}
+	}
+}
+
+func (s *SlashingMetric) launchListener(ctx context.Context, url string) {
+	service, err := http.New(
+		ctx,
+		http.WithLogLevel(zerolog.DebugLevel),
+		http.WithAddress(url),
+	)
+	if err != nil {
+		logger.WriteError(metric.ValidatorGroup, s.Name, errors.Join(err, fmt.Errorf("failed to instantiate Consensus Client, falling back to block contents. Address: '%s'", url)))
+		return
+	}
+
+	if err := service.(client.EventsProvider).Events(ctx, &api.EventsOpts{
+		Topics: []string{attesterSlashingTopic, proposerSlashingTopic},
+		AttesterSlashingHandler: func(_ context.Context, event *electra.AttesterSlashing) {
+			if event.Attestation1 == nil || event.Attestation2 == nil || event.Attestation1.Data == nil {
+				return
+			}
+			s.record(attesterSlashingTopic, event.Attestation1.Data.Slot, slashingSourceEvent,
+				slashedIndices(event.Attestation1.AttestingIndices, event.Attestation2.AttestingIndices))
+		},
+		ProposerSlashingHandler: func(_ context.Context, event *phase0.ProposerSlashing) {
+			if event.SignedHeader1 == nil || event.SignedHeader1.Message == nil {
+				return
+			}
+			header := event.SignedHeader1.Message
+			s.record(proposerSlashingTopic, header.Slot, slashingSourceEvent, []phase0.ValidatorIndex{header.ProposerIndex})
+		},
+	}); err != nil {
+		logger.WriteError(metric.ValidatorGroup, s.Name, errors.Join(err, fmt.Errorf("failed subscribing to slashing events, falling back to block contents. Address: '%s'", url)))
+	}
+}
+
+func (s *SlashingMetric) measure(ctx context.Context, slot phase0.Slot) {
+	if slot == 0 {
+		return
+	}
+
+	var resp struct {
+		Data struct {
+			Message struct {
+				Body struct {
+					ProposerSlashings []struct {
+						SignedHeader1 struct {
+							Message struct {
+								Slot          string `json:"slot"`
+								ProposerIndex string `json:"proposer_index"`
+							} `json:"message"`
+						} `json:"signed_header_1"`
+					} `json:"proposer_slashings"`
+					AttesterSlashings []struct {
+						Attestation1 slashedAttestation `json:"attestation_1"`
+						Attestation2 slashedAttestation `json:"attestation_2"`
+					} `json:"attester_slashings"`
+				} `json:"body"`
+			} `json:"message"`
+		} `json:"data"`
+	}
+
+	blockSlot := slot - 1
+	err := fetchWithFallback(ctx, s.urls, fmt.Sprintf("/eth/v2/beacon/blocks/%d", blockSlot), &resp)
+	if errors.Is(err, errNotFound) {
+		return
+	}
+	if err != nil {
+		logger.WriteError(metric.ValidatorGroup, s.Name, errors.Join(err, fmt.Errorf("failed fetching block of slot: %d", blockSlot)))
+		return
+	}
+
+	for _, proposerSlashing := range resp.Data.Message.Body.ProposerSlashings {
+		header := proposerSlashing.SignedHeader1.Message
+		proposerIndex, err := strconv.ParseUint(header.ProposerIndex, 10, 64)
+		if err != nil {
+			logger.WriteError(metric.ValidatorGroup, s.Name, errors.Join(err, errors.New("failed parsing slashed proposer index")))
+			continue
+		}
+		headerSlot, err := strconv.ParseUint(header.Slot, 10, 64)
+		if err != nil {
+			logger.WriteError(metric.ValidatorGroup, s.Name, errors.Join(err, errors.New("failed parsing slashed header slot")))
+			continue
+		}
+		s.record(proposerSlashingTopic, phase0.Slot(headerSlot), slashingSourceBlock, []phase0.ValidatorIndex{phase0.ValidatorIndex(proposerIndex)})
+	}
+
+	for _, attesterSlashing := range resp.Data.Message.Body.AttesterSlashings {
+		first, err := attesterSlashing.Attestation1.indices()
+		if err != nil {
+			logger.WriteError(metric.ValidatorGroup, s.Name, err)
+			continue
+		}
+		second, err := attesterSlashing.Attestation2.indices()
+		if err != nil {
+			logger.WriteError(metric.ValidatorGroup, s.Name, err)
+			continue
+		}
+		attestationSlot, err := strconv.ParseUint(attesterSlashing.Attestation1.Data.Slot, 10, 64)
+		if err != nil {
+			logger.WriteError(metric.ValidatorGroup, s.Name, errors.Join(err, errors.New("failed parsing slashed attestation slot")))
+			continue
+		}
+		s.record(attesterSlashingTopic, phase0.Slot(attestationSlot), slashingSourceBlock, slashedIndices(first, second))
+	}
+}
+
+func (a slashedAttestation) indices() ([]uint64, error) {
+	indices := make([]uint64, 0, len(a.AttestingIndices))
+	for _, index := range a.AttestingIndices {
+		parsed, err := strconv.ParseUint(index, 10, 64)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed parsing slashed attester index"))
+		}
+		indices = append(indices, parsed)
+	}
+	return indices, nil
+}
+
+// slashedIndices returns validators which attested in both conflicting attestations, only those are slashed.
+func slashedIndices(first, second []uint64) []phase0.ValidatorIndex {
+	var indices []phase0.ValidatorIndex
+	for _, index := range first {
+		if slices.Contains(second, index) {
+			indices = append(indices, phase0.ValidatorIndex(index))
+		}
+	}
+	return indices
+}
+
+func (s *SlashingMetric) record(slashingType string, slot phase0.Slot, source string, indices []phase0.ValidatorIndex) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, index := range indices {
+		if _, ok := s.slashed[index]; ok {
+			continue
+		}
+		s.slashed[index] = slashing{
+			slashingType: slashingType,
+			slot:         slot,
+			source:       source,
+			detected:     time.Now(),
+		}
+
+		measurements := map[string]float64{
+			NetworkSlashingMeasurement: 1,
+		}
+		if slices.Contains(s.validators, index) {
+			measurements[SlashedValidatorMeasurement] = 1
+			validatorSlashedMetric.With(validatorTypeLabels(strconv.FormatUint(uint64(index), 10), slashingType)).Set(1)
+			slog.
+				With("metric_name", s.Name).
+				With("validator_index", index).
+				With("type", slashingType).
+				With("slot", slot).
+				Error("validator of the cluster was slashed")
+		}
+
+		s.AddDataPoint(measurements)
+		networkSlashingsMetric.With(typeLabel(slashingType)).Inc()
+
+		logger.WriteMetric(metric.ValidatorGroup, s.Name, map[string]any{
+			NetworkSlashingMeasurement:  measurements[NetworkSlashingMeasurement],
+			SlashedValidatorMeasurement: measurements[SlashedValidatorMeasurement],
+		}, map[string]any{
+			"validator_index": index,
+			"type":            slashingType,
+			"slot":            slot,
+			"source":          source,
+		})
+	}
+}
+
+func (s *SlashingMetric) AggregateResults() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		proposerSlashings, attesterSlashings int
+		cluster                              []string
+	)
+	for index, slashing := range s.slashed {
+		switch slashing.slashingType {
+		case proposerSlashingTopic:
+			proposerSlashings++
+		case attesterSlashingTopic:
+			attesterSlashings++
+		}
+		if slices.Contains(s.validators, index) {
+			cluster = append(cluster, fmt.Sprintf("%d (%s@%d, detected via %s at %s)",
+				index,
+				slashing.slashingType,
+				slashing.slot,
+				slashing.source,
+				slashing.detected.UTC().Format(time.DateTime)))
+		}
+	}
+	slices.Sort(cluster)
+
+	return fmt.Sprintf("network_slashed=%d (proposer=%d, attester=%d), cluster_slashed=%d %v",
+		len(s.slashed),
+		proposerSlashings,
+		attesterSlashings,
+		len(cluster),
+		cluster)
+}
@@ -0,0 +1,53 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+func TestSlashingMetric_Measure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v2/beacon/blocks/5":
+			_, _ = w.Write([]byte(`{"version":"electra","data":{"message":{"slot":"5","body":{
+				"proposer_slashings":[{"signed_header_1":{"message":{"slot":"3","proposer_index":"7"}},"signed_header_2":{"message":{"slot":"3","proposer_index":"7"}}}],
+				"attester_slashings":[{
+					"attestation_1":{"attesting_indices":["1","2","10"],"data":{"slot":"2"}},
+					"attestation_2":{"attesting_indices":["2","10","11"],"data":{"slot":"2"}}
+				}]
+			}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	metric := NewSlashingMetric([]string{server.URL}, "Slashing", network.Supported[network.Mainnet], []phase0.ValidatorIndex{10, 20}, nil)
+	// the same proposer slashing received as an event before the block is only recorded once
+	metric.record(proposerSlashingTopic, 3, slashingSourceEvent, []phase0.ValidatorIndex{7})
+	for slot := phase0.Slot(1); slot <= 8; slot++ {
+		metric.measure(context.Background(), slot)
+	}
+
+	require.Len(t, metric.slashed, 3)
+	assert.Equal(t, slashingSourceEvent, metric.slashed[7].source)
+	assert.Equal(t, attesterSlashingTopic, metric.slashed[10].slashingType)
+	assert.Equal(t, phase0.Slot(2), metric.slashed[2].slot)
+	assert.NotContains(t, metric.slashed, phase0.ValidatorIndex(1))
+
+	var clusterSlashings int
+	for _, dp := range metric.DataPoints {
+		clusterSlashings += int(dp.Values[SlashedValidatorMeasurement])
+	}
+	assert.Equal(t, 1, clusterSlashings)
+	assert.Contains(t, metric.AggregateResults(), "network_slashed=3 (proposer=1, attester=2), cluster_slashed=1")
+}
@@ -0,0 +1,29 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+func slotTime(network network.Network, slot phase0.Slot) time.Time {
+	return network.GenesisTime.Add(time.Duration(slot) * network.SlotDuration())
+}
+
+func currentSlot(network network.Network) phase0.Slot {
+	return phase0.Slot(time.Since(network.GenesisTime) / network.SlotDuration())
+}
+
+func slotEpoch(network network.Network, slot phase0.Slot) phase0.Epoch {
+	return phase0.Epoch(uint64(slot) / network.SlotsPerEpoch)
+}
+
+func epochStartSlot(network network.Network, epoch phase0.Epoch) phase0.Slot {
+	return phase0.Slot(uint64(epoch) * network.SlotsPerEpoch)
+}
+
+func currentEpoch(network network.Network) phase0.Epoch {
+	return slotEpoch(network, currentSlot(network))
+}
@@ -0,0 +1,118 @@
+package consensus
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+const (
+	genesisForkName = "phase0"
+	farFutureEpoch  = ^uint64(0)
+)
+
+// FetchNetwork builds network parameters from the beacon node '/eth/v1/beacon/genesis' and '/eth/v1/config/spec' endpoints.
+// The network name is taken from the 'CONFIG_NAME' spec value.
+func FetchNetwork(ctx context.Context, url string) (network.Network, error) {
+	var (
+		genesisResp struct {
+			Data struct {
+				GenesisTime        string `json:"genesis_time"`
+				GenesisForkVersion string `json:"genesis_fork_version"`
+			} `json:"data"`
+		}
+		specResp struct {
+			Data map[string]any `json:"data"`
+		}
+	)
+
+	if err := fetch(ctx, fmt.Sprintf("%s/eth/v1/beacon/genesis", url), &genesisResp); err != nil {
+		return network.Network{}, errors.Join(err, errors.New("failed fetching genesis"))
+	}
+	if err := fetch(ctx, fmt.Sprintf("%s/eth/v1/config/spec", url), &specResp); err != nil {
+		return network.Network{}, errors.Join(err, errors.New("failed fetching spec"))
+	}
+
+	genesisTime, err := strconv.ParseInt(genesisResp.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return network.Network{}, errors.Join(err, errors.New("failed parsing genesis time"))
+	}
+
+	spec := make(map[string]string, len(specResp.Data))
+	for key, value := range specResp.Data {
+		if str, ok := value.(string); ok {
+			spec[key] = str
+		}
+	}
+
+	secondsPerSlot, err := parseSecondsPerSlot(spec)
+	if err != nil {
+		return network.Network{}, err
+	}
+	slotsPerEpoch, err := strconv.ParseUint(spec["SLOTS_PER_EPOCH"], 10, 64)
+	if err != nil {
+		return network.Network{}, errors.Join(err, errors.New("failed parsing SLOTS_PER_EPOCH"))
+	}
+
+	return network.Network{
+		Name:           network.Name(strings.ToLower(spec["CONFIG_NAME"])),
+		GenesisTime:    time.Unix(genesisTime, 0),
+		SecondsPerSlot: secondsPerSlot,
+		SlotsPerEpoch:  slotsPerEpoch,
+		Forks:          parseForks(spec, genesisResp.Data.GenesisForkVersion),
+	}, nil
+}
+
+func parseSecondsPerSlot(spec map[string]string) (uint64, error) {
+	if value, ok := spec["SECONDS_PER_SLOT"]; ok {
+		secondsPerSlot, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return 0, errors.Join(err, errors.New("failed parsing SECONDS_PER_SLOT"))
+		}
+		return secondsPerSlot, nil
+	}
+
+	slotDurationMs, err := strconv.ParseUint(spec["SLOT_DURATION_MS"], 10, 64)
+	if err != nil {
+		return 0, errors.Join(err, errors.New("failed parsing SLOT_DURATION_MS"))
+	}
+	return slotDurationMs / 1000, nil
+}
+
+func parseForks(spec map[string]string, genesisForkVersion string) []network.Fork {
+	forks := []network.Fork{
+		{Name: genesisForkName, Epoch: 0, Version: genesisForkVersion},
+	}
+
+	for key, value := range spec {
+		prefix, ok := strings.CutSuffix(key, "_FORK_EPOCH")
+		if !ok {
+			continue
+		}
+		epoch, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || epoch == farFutureEpoch {
+			continue
+		}
+		forks = append(forks, network.Fork{
+			Name:    strings.ToLower(prefix),
+			Epoch:   epoch,
+			Version: spec[prefix+"_FORK_VERSION"],
+		})
+	}
+
+	slices.SortFunc(forks, func(a, b network.Fork) int {
+		if c := cmp.Compare(a.Epoch, b.Epoch); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	return forks
+}
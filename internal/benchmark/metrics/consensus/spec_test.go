@@ -0,0 +1,65 @@
+package consensus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+func TestFetchNetwork(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/eth/v1/beacon/genesis":
+			_, _ = w.Write([]byte(`{"data":{"genesis_time":"1742213400","genesis_fork_version":"0x10000910"}}`))
+		case "/eth/v1/config/spec":
+			_, _ = w.Write([]byte(`{"data":{
+				"CONFIG_NAME":"Kurtosis",
+				"SECONDS_PER_SLOT":"6",
+				"SLOTS_PER_EPOCH":"8",
+				"ALTAIR_FORK_EPOCH":"0",
+				"ALTAIR_FORK_VERSION":"0x20000910",
+				"ELECTRA_FORK_EPOCH":"2048",
+				"ELECTRA_FORK_VERSION":"0x60000910",
+				"FULU_FORK_EPOCH":"18446744073709551615",
+				"FULU_FORK_VERSION":"0x70000910",
+				"DEPOSIT_CHAIN_ID":"560048"
+			}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	actual, err := FetchNetwork(context.Background(), server.URL)
+	require.NoError(t, err)
+
+	assert.Equal(t, network.Network{
+		Name:           "kurtosis",
+		GenesisTime:    time.Unix(1742213400, 0),
+		SecondsPerSlot: 6,
+		SlotsPerEpoch:  8,
+		Forks: []network.Fork{
+			{Name: "phase0", Epoch: 0, Version: "0x10000910"},
+			{Name: "altair", Epoch: 0, Version: "0x20000910"},
+			{Name: "electra", Epoch: 2048, Version: "0x60000910"},
+		},
+	}, actual)
+}
+
+func TestFetchNetwork_UnsuccessfulResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := FetchNetwork(context.Background(), server.URL)
+	require.ErrorContains(t, err, "failed fetching genesis")
+}
@@ -0,0 +1,63 @@
+package consensus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// validatorIDsPerRequest keeps the query string of '/eth/v1/beacon/states/head/validators' within common URL length limits
+const validatorIDsPerRequest = 30
+
+// FetchValidatorIndices resolves validator public keys to validator indices using the head state
+func FetchValidatorIndices(ctx context.Context, urls []string, pubKeys []string) ([]phase0.ValidatorIndex, error) {
+	var indices []phase0.ValidatorIndex
+
+	for chunk := range slices.Chunk(pubKeys, validatorIDsPerRequest) {
+		var resp struct {
+			Data []struct {
+				Index     string `json:"index"`
+				Validator struct {
+					PubKey string `json:"pubkey"`
+				} `json:"validator"`
+			} `json:"data"`
+		}
+
+		path := fmt.Sprintf("/eth/v1/beacon/states/head/validators?id=%s", strings.Join(chunk, ","))
+		if err := fetchWithFallback(ctx, urls, path, &resp); err != nil {
+			return nil, errors.Join(err, errors.New("failed fetching validators"))
+		}
+
+		byPubKey := make(map[string]string, len(resp.Data))
+		for _, v := range resp.Data {
+			byPubKey[strings.ToLower(v.Validator.PubKey)] = v.Index
+		}
+
+		for _, pubKey := range chunk {
+			rawIndex, ok := byPubKey[strings.ToLower(pubKey)]
+			if !ok {
+				return nil, fmt.Errorf("validator with public key: '%s' was not found", pubKey)
+			}
+			index, err := strconv.ParseUint(rawIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Join(err, fmt.Errorf("failed parsing index of validator: '%s'", pubKey))
+			}
+			indices = append(indices, phase0.ValidatorIndex(index))
+		}
+	}
+
+	return indices, nil
+}
+
+func validatorIndicesAsStrings(indices []phase0.ValidatorIndex) []string {
+	result := make([]string, 0, len(indices))
+	for _, index := range indices {
+		result = append(result, strconv.FormatUint(uint64(index), 10))
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Block identifies an execution block along with its timestamp
+type Block struct {
+	Number    uint64
+	Hash      string
+	Timestamp uint64
+}
+
+// FetchBlock returns the block with the given block tag or hex encoded number, e.g. 'latest' or '0x10'.
+// Execution clients are queried in order and the first successful response is used.
+func FetchBlock(ctx context.Context, urls []string, blockTag string) (Block, error) {
+	var fetchErr error
+	for _, url := range urls {
+		block, err := fetchBlock(ctx, url, blockTag)
+		if err == nil {
+			return block, nil
+		}
+		fetchErr = errors.Join(fetchErr, fmt.Errorf("execution client: '%s': %w", url, err))
+	}
+	if fetchErr == nil {
+		return Block{}, errors.New("no execution client address configured")
+	}
+	return Block{}, fetchErr
+}
+
+func fetchBlock(ctx context.Context, url, blockTag string) (Block, error) {
+	var resp *struct {
+		Number    string `json:"number"`
+		Hash      string `json:"hash"`
+		Timestamp string `json:"timestamp"`
+	}
+	if err := call(ctx, url, "eth_getBlockByNumber", []any{blockTag, false}, &resp); err != nil {
+		return Block{}, err
+	}
+	// the result is null when the execution client does not have the block yet
+	if resp == nil {
+		return Block{}, fmt.Errorf("block: '%s' was not found", blockTag)
+	}
+
+	number, err := parseHexUint(resp.Number)
+	if err != nil {
+		return Block{}, errors.Join(err, errors.New("failed parsing block number"))
+	}
+	timestamp, err := parseHexUint(resp.Timestamp)
+	if err != nil {
+		return Block{}, errors.Join(err, errors.New("failed parsing block timestamp"))
+	}
+
+	return Block{
+		Number:    number,
+		Hash:      resp.Hash,
+		Timestamp: timestamp,
+	}, nil
+}
@@ -0,0 +1,189 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
+)
+
+const (
+	VersionMeasurement        = "Version"
+	VersionPolicyMeasurement  = "VersionPolicy"
+	MissingMethodsMeasurement = "MissingMethods"
+
+	// methodNotFoundCode is the JSON-RPC error code returned for methods which do not exist or are not enabled
+	methodNotFoundCode = -32601
+)
+
+// methodNotAvailablePattern matches the message of clients returning the geth style error under a different code
+var methodNotAvailablePattern = regexp.MustCompile(`^the method \S+ does not exist/is not available$`)
+
+// requiredMethods are the JSON-RPC methods the SSV node calls on the execution client, along with the params they are probed with
+var requiredMethods = []struct {
+	method string
+	params []any
+}{
+	{method: "eth_chainId"},
+	{method: "eth_blockNumber"},
+	{method: "eth_syncing"},
+	{method: "eth_getBlockByNumber", params: []any{"latest", false}},
+	{method: "eth_getLogs", params: []any{map[string]any{"fromBlock": "latest", "toBlock": "latest"}}},
+	{method: "eth_call", params: []any{map[string]any{"to": "0x0000000000000000000000000000000000000000", "data": "0x"}, "latest"}},
+}
+
+// isMethodNotFound reports whether the client rejected the call because the method is unknown or its namespace is disabled
+func isMethodNotFound(err error) bool {
+	var rpcErr *rpcError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	// other errors, e.g. '-32000 header not found', are returned by the method itself and do not mean it is missing
+	return rpcErr.Code == methodNotFoundCode || methodNotAvailablePattern.MatchString(rpcErr.Message)
+}
+
+// ClientMetric reports the client name and version returned by 'web3_clientVersion', evaluates it against the version policy
+// and probes the JSON-RPC methods required by the SSV node.
+type ClientMetric struct {
+	metric.Base[string]
+	url      string
+	interval time.Duration
+	network  network.Name
+	policy   version.Policy
+}
+
+func NewClientMetric(url, name string, interval time.Duration, network network.Name, policy version.Policy, healthCondition []metric.HealthCondition[string]) *ClientMetric {
+	return &ClientMetric{
+		url: url,
+		Base: metric.Base[string]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+		network:  network,
+		policy:   policy,
+	}
+}
+
+func (c *ClientMetric) Measure(ctx context.Context) {
+	c.measure(ctx)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", c.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			c.measure(ctx)
+		}
+	}
+}
+
+func (c *ClientMetric) measure(ctx context.Context) {
+	var rawVersion string
+	if err := call(ctx, c.url, "web3_clientVersion", nil, &rawVersion); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		c.writeMetric("", version.StatusUnknown, nil)
+		logger.WriteError(metric.ExecutionGroup, c.Name, errors.Join(err, errors.New("failed fetching client version")))
+		return
+	}
+
+	status := version.StatusUnknown
+	info, err := version.Parse(rawVersion)
+	if err != nil {
+		logger.WriteError(metric.ExecutionGroup, c.Name, err)
+	} else {
+		status = c.policy.Check(info, string(c.network))
+	}
+
+	var missing []string
+	for _, required := range requiredMethods {
+		err := call(ctx, c.url, required.method, required.params, nil)
+		if ctx.Err() != nil {
+			return
+		}
+		if isMethodNotFound(err) {
+			missing = append(missing, required.method)
+			continue
+		}
+		if err != nil {
+			// any other failure, e.g. a reverted call, still proves that the method is available
+			slog.
+				With("metric_name", c.Name).
+				With("method", required.method).
+				With("err", err).
+				Debug("required method probe failed")
+		}
+	}
+
+	c.writeMetric(rawVersion, status, missing)
+}
+
+func (c *ClientMetric) writeMetric(rawVersion string, status version.Status, missing []string) {
+	c.AddDataPoint(map[string]string{
+		VersionMeasurement:        rawVersion,
+		VersionPolicyMeasurement:  string(status),
+		MissingMethodsMeasurement: strings.Join(missing, ","),
+	})
+
+	if rawVersion != "" {
+		// the series of the previous version or status would otherwise keep reporting 1 after an upgrade
+		clientVersionMetric.DeletePartialMatch(serverAddrLabel(c.url))
+		clientVersionMetric.With(clientVersionLabels(c.url, rawVersion, status)).Set(1)
+		for _, required := range requiredMethods {
+			var supported float64
+			if !slices.Contains(missing, required.method) {
+				supported = 1
+			}
+			methodSupportedMetric.With(rpcMethodLabels(c.url, required.method)).Set(supported)
+		}
+	}
+
+	logger.WriteMetric(metric.ExecutionGroup, c.Name, map[string]any{
+		VersionMeasurement:        rawVersion,
+		VersionPolicyMeasurement:  status,
+		MissingMethodsMeasurement: strings.Join(missing, ","),
+	})
+}
+
+func (c *ClientMetric) AggregateResults() string {
+	var (
+		versions []string
+		latest   map[string]string
+	)
+	for _, point := range c.DataPoints {
+		if v := point.Values[VersionMeasurement]; v != "" {
+			latest = point.Values
+			if !slices.Contains(versions, v) {
+				versions = append(versions, v)
+			}
+		}
+	}
+	if len(versions) == 0 {
+		return ""
+	}
+
+	result := fmt.Sprintf("%s, policy=%s, missing_methods=[%s]",
+		latest[VersionMeasurement],
+		latest[VersionPolicyMeasurement],
+		latest[MissingMethodsMeasurement])
+	if len(versions) > 1 {
+		result += fmt.Sprintf(" \n observed_versions=[%s]", strings.Join(versions, ", "))
+	}
+
+	return result
+}
@@ -0,0 +1,76 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
+)
+
+func TestClientMetric_Measure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch request.Method {
+		case "web3_clientVersion":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"Geth/v1.14.11-stable-f3c696fa/linux-amd64/go1.23.2"}`))
+		case GetLogsMethod:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method eth_getLogs does not exist/is not available"}}`))
+		case CallMethod:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"execution reverted"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		}
+	}))
+	defer server.Close()
+
+	policy := version.Policy{Rules: []version.Rule{{Client: version.Geth, Minimum: "v1.15.0"}}}
+	clientMetric := NewClientMetric(server.URL, "Client", time.Minute, network.Name("mainnet"), policy, []metric.HealthCondition[string]{
+		{Name: MissingMethodsMeasurement, Threshold: "", Operator: metric.OperatorGreaterThan, Severity: metric.SeverityHigh},
+	})
+	clientMetric.measure(context.Background())
+
+	require.Len(t, clientMetric.DataPoints, 1)
+	values := clientMetric.DataPoints[0].Values
+	assert.Equal(t, "Geth/v1.14.11-stable-f3c696fa/linux-amd64/go1.23.2", values[VersionMeasurement])
+	assert.Equal(t, string(version.StatusOutdated), values[VersionPolicyMeasurement])
+	assert.Equal(t, "eth_getLogs", values[MissingMethodsMeasurement])
+
+	_, severities := clientMetric.EvaluateMetric()
+	assert.Equal(t, metric.SeverityHigh, severities[MissingMethodsMeasurement])
+}
+
+func TestIsMethodNotFound(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "Method not found code", err: &rpcError{Code: -32601, Message: "Method not found"}, expected: true},
+		{name: "Method not available message", err: &rpcError{Code: -32000, Message: "the method eth_getLogs does not exist/is not available"}, expected: true},
+		{name: "Header not found", err: &rpcError{Code: -32000, Message: "header not found"}},
+		{name: "Execution reverted", err: &rpcError{Code: 3, Message: "execution reverted"}},
+		{name: "Not a JSON-RPC error", err: errors.New("method not found")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isMethodNotFound(fmt.Errorf("wrapped: %w", tt.err)))
+		})
+	}
+}
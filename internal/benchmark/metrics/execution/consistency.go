@@ -0,0 +1,169 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/consensus"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	HeadMismatchMeasurement = "HeadMismatch"
+	MismatchRateMeasurement = "MismatchRate"
+	BlockLagMeasurement     = "BlockLag"
+
+	// consistencyWindow is the number of latest checks the mismatch rate is calculated over. The rate is only reported once
+	// the window is full, so that a single mismatch on the first check does not produce a rate of 100%.
+	consistencyWindow = 30
+)
+
+// ConsistencyMetric pairs the execution client with the consensus client it is expected to serve and compares the execution
+// payload of the beacon head with the block the execution client has at the same number. A mismatch means that the pair
+// is on different heads, which explains 'el_offline' and optimistic states of the beacon node. The measured mismatch rate
+// covers the latest checks, the aggregated one covers the whole run.
+type ConsistencyMetric struct {
+	metric.Base[float64]
+	url          string
+	consensusURL string
+	interval     time.Duration
+	checks       uint64
+	mismatches   uint64
+	window       []bool
+}
+
+func NewConsistencyMetric(url, consensusURL, name string, interval time.Duration, healthCondition []metric.HealthCondition[float64]) *ConsistencyMetric {
+	return &ConsistencyMetric{
+		url:          url,
+		consensusURL: consensusURL,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (c *ConsistencyMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", c.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			c.measure(ctx)
+		}
+	}
+}
+
+func (c *ConsistencyMetric) measure(ctx context.Context) {
+	payload, err := consensus.FetchExecutionPayload(ctx, []string{c.consensusURL}, "head")
+	if err != nil {
+		logger.WriteError(metric.ExecutionGroup, c.Name, errors.Join(err, errors.New("failed fetching execution payload of the beacon head")))
+		return
+	}
+
+	var (
+		blockNumber string
+		block       *struct {
+			Hash string `json:"hash"`
+		}
+	)
+	if err := call(ctx, c.url, "eth_blockNumber", nil, &blockNumber); err != nil {
+		logger.WriteError(metric.ExecutionGroup, c.Name, errors.Join(err, errors.New("failed fetching block number")))
+		return
+	}
+	// the result is null when the execution client does not have the block yet
+	if err := call(ctx, c.url, "eth_getBlockByNumber", []any{fmt.Sprintf("0x%x", payload.BlockNumber), false}, &block); err != nil {
+		logger.WriteError(metric.ExecutionGroup, c.Name, errors.Join(err, fmt.Errorf("failed fetching block: '%d'", payload.BlockNumber)))
+		return
+	}
+
+	headNumber, err := parseHexUint(blockNumber)
+	if err != nil {
+		logger.WriteError(metric.ExecutionGroup, c.Name, errors.Join(err, errors.New("failed parsing block number")))
+		return
+	}
+
+	var lag float64
+	if payload.BlockNumber > headNumber {
+		lag = float64(payload.BlockNumber - headNumber)
+	}
+
+	var elHash string
+	if block != nil {
+		elHash = block.Hash
+	}
+
+	values := map[string]float64{
+		HeadMismatchMeasurement: 0,
+		BlockLagMeasurement:     lag,
+	}
+	c.checks++
+	mismatch := !strings.EqualFold(elHash, payload.BlockHash)
+	if mismatch {
+		c.mismatches++
+		values[HeadMismatchMeasurement] = 1
+	}
+	c.window = append(c.window, mismatch)
+	if len(c.window) > consistencyWindow {
+		c.window = c.window[len(c.window)-consistencyWindow:]
+	}
+	if len(c.window) == consistencyWindow {
+		var mismatches float64
+		for _, mismatch := range c.window {
+			if mismatch {
+				mismatches++
+			}
+		}
+		values[MismatchRateMeasurement] = mismatches / consistencyWindow * 100
+	}
+
+	c.writeMetric(values, payload, elHash)
+}
+
+func (c *ConsistencyMetric) writeMetric(values map[string]float64, payload consensus.ExecutionPayload, elHash string) {
+	c.AddDataPoint(values)
+
+	headMismatchMetric.With(pairLabels(c.url, c.consensusURL)).Set(values[HeadMismatchMeasurement])
+	pairBlockLagMetric.With(pairLabels(c.url, c.consensusURL)).Set(values[BlockLagMeasurement])
+
+	loggerValues := make(map[string]any, len(values))
+	for name, value := range values {
+		loggerValues[name] = value
+	}
+	logger.WriteMetric(metric.ExecutionGroup, c.Name, loggerValues, map[string]any{
+		"consensus_address": c.consensusURL,
+		"block_number":      payload.BlockNumber,
+		"consensus_hash":    payload.BlockHash,
+		"execution_hash":    elHash,
+	})
+}
+
+func (c *ConsistencyMetric) AggregateResults() string {
+	var lags []float64
+	for _, point := range c.DataPoints {
+		lags = append(lags, point.Values[BlockLagMeasurement])
+	}
+	percentiles := metric.CalculatePercentiles(lags, 0, 10, 50, 90, 100)
+
+	var rate float64
+	if c.checks != 0 {
+		rate = float64(c.mismatches) / float64(c.checks) * 100
+	}
+
+	return fmt.Sprintf("consensus=%s, mismatches=%d/%d (%.2f%%) \n block_lag: %s",
+		c.consensusURL,
+		c.mismatches,
+		c.checks,
+		rate,
+		metric.FormatPercentiles(percentiles[0], percentiles[10], percentiles[50], percentiles[90], percentiles[100]))
+}
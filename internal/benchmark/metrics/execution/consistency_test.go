@@ -0,0 +1,61 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsistencyMetric_Measure(t *testing.T) {
+	var blockHash string
+	executionServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request rpcRequest
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&request)) {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch request.Method {
+		case "eth_blockNumber":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x6c"}`))
+		case "eth_getBlockByNumber":
+			assert.Equal(t, "0x6e", request.Params[0])
+			if blockHash == "" {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":null}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"hash":"` + blockHash + `"}}`))
+		}
+	}))
+	defer executionServer.Close()
+
+	consensusServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"message":{"body":{"execution_payload":{"block_number":"110","block_hash":"0xABCD","timestamp":"0"}}}}}`))
+	}))
+	defer consensusServer.Close()
+
+	metric := NewConsistencyMetric(executionServer.URL, consensusServer.URL, "Consistency", time.Second, nil)
+
+	blockHash = ""
+	metric.measure(context.Background())
+	blockHash = "0xabcd"
+	for range consistencyWindow {
+		metric.measure(context.Background())
+	}
+
+	require.Len(t, metric.DataPoints, consistencyWindow+1)
+	assert.Equal(t, 1.0, metric.DataPoints[0].Values[HeadMismatchMeasurement])
+	assert.Equal(t, 2.0, metric.DataPoints[0].Values[BlockLagMeasurement])
+	// the rate is only reported once the window is full and drops to 0 once the mismatch of the first check leaves it
+	assert.NotContains(t, metric.DataPoints[0].Values, MismatchRateMeasurement)
+	assert.Equal(t, 100.0/consistencyWindow, metric.DataPoints[consistencyWindow-1].Values[MismatchRateMeasurement])
+	assert.Equal(t, 0.0, metric.DataPoints[consistencyWindow].Values[MismatchRateMeasurement])
+	assert.Equal(t, 0.0, metric.DataPoints[consistencyWindow].Values[HeadMismatchMeasurement])
+}
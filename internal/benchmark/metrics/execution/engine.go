@@ -0,0 +1,220 @@
+package execution
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	EngineAuthFailureMeasurement = "AuthFailure"
+	EngineUnreachableMeasurement = "Unreachable"
+	EngineDurationMeasurement    = "Duration"
+
+	jwtSecretLength = 32
+)
+
+// engineMethods are offered to the execution client in 'engine_exchangeCapabilities', the same way a consensus client does
+var engineMethods = []string{
+	"engine_newPayloadV1", "engine_newPayloadV2", "engine_newPayloadV3", "engine_newPayloadV4",
+	"engine_forkchoiceUpdatedV1", "engine_forkchoiceUpdatedV2", "engine_forkchoiceUpdatedV3",
+	"engine_getPayloadV1", "engine_getPayloadV2", "engine_getPayloadV3", "engine_getPayloadV4",
+	"engine_getPayloadBodiesByHashV1", "engine_getPayloadBodiesByRangeV1",
+	"engine_getBlobsV1",
+}
+
+// LoadJWTSecret reads the hex encoded 32 bytes secret shared by the consensus and the execution client
+func LoadJWTSecret(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("failed reading JWT secret file: '%s'", path))
+	}
+
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(content)), "0x"))
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("JWT secret file: '%s' was not a valid hex string", path))
+	}
+	if len(secret) != jwtSecretLength {
+		return nil, fmt.Errorf("JWT secret was %d bytes long, expected %d bytes", len(secret), jwtSecretLength)
+	}
+
+	return secret, nil
+}
+
+// jwtToken returns an HS256 token with the 'iat' claim only. Execution clients reject tokens issued more than 60 seconds
+// away from their clock, so a new token is signed for every request.
+func jwtToken(secret []byte, issuedAt time.Time) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"iat":%d}`, issuedAt.Unix())))
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(header + "." + claims))
+
+	return header + "." + claims + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// engineMethodVersions maps Engine API methods to the highest version supported by the execution client,
+// e.g. 'engine_newPayloadV3' and 'engine_newPayloadV4' to 'engine_newPayload: 4'
+func engineMethodVersions(capabilities []string) map[string]int {
+	versions := make(map[string]int)
+	for _, capability := range capabilities {
+		i := strings.LastIndex(capability, "V")
+		if i <= 0 {
+			continue
+		}
+		v, err := strconv.Atoi(capability[i+1:])
+		if err != nil {
+			continue
+		}
+		method := capability[:i]
+		versions[method] = max(versions[method], v)
+	}
+	return versions
+}
+
+// EngineMetric checks the authenticated Engine API the consensus client is paired with. It signs a JWT with the shared secret,
+// calls 'engine_exchangeCapabilities' and 'eth_chainId' on the auth port and reports rejected tokens, which
+// indicate that the consensus and execution clients do not share the same JWT secret.
+type EngineMetric struct {
+	metric.Base[float64]
+	url          string
+	interval     time.Duration
+	secret       []byte
+	chainID      uint64
+	durations    []float64
+	authFailures uint64
+	unreachable  uint64
+	versions     map[string]int
+}
+
+func NewEngineMetric(url, name string, interval time.Duration, secret []byte, chainID uint64, healthCondition []metric.HealthCondition[float64]) *EngineMetric {
+	return &EngineMetric{
+		url: url,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+		secret:   secret,
+		chainID:  chainID,
+	}
+}
+
+func (e *EngineMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", e.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			e.measure(ctx)
+		}
+	}
+}
+
+func (e *EngineMetric) measure(ctx context.Context) {
+	values := map[string]float64{
+		EngineAuthFailureMeasurement: 0,
+		EngineUnreachableMeasurement: 0,
+	}
+
+	var capabilities []string
+	start := time.Now()
+	err := callWithHeader(ctx, e.url, requestTimeout, e.authHeader(), "engine_exchangeCapabilities", []any{engineMethods}, &capabilities)
+	duration := time.Since(start)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, errUnauthorized) {
+			values[EngineAuthFailureMeasurement] = 1
+			logger.WriteError(metric.ExecutionGroup, e.Name, errors.Join(err, errors.New("engine API rejected the JWT. The JWT secret most likely differs from the one used by the execution client")))
+		} else {
+			values[EngineUnreachableMeasurement] = 1
+			logger.WriteError(metric.ExecutionGroup, e.Name, errors.Join(err, errors.New("failed exchanging engine API capabilities")))
+		}
+		e.writeMetric(values)
+		return
+	}
+	values[EngineDurationMeasurement] = duration.Seconds()
+	e.versions = engineMethodVersions(capabilities)
+
+	var chainIDHex string
+	if err := callWithHeader(ctx, e.url, requestTimeout, e.authHeader(), "eth_chainId", nil, &chainIDHex); err != nil {
+		logger.WriteError(metric.ExecutionGroup, e.Name, errors.Join(err, errors.New("failed fetching chain ID from the engine API")))
+	} else if chainID, err := parseHexUint(chainIDHex); err != nil {
+		logger.WriteError(metric.ExecutionGroup, e.Name, errors.Join(err, errors.New("failed parsing chain ID")))
+	} else if e.chainID != 0 {
+		values[NetworkMismatchMeasurement] = 0
+		if chainID != e.chainID {
+			values[NetworkMismatchMeasurement] = 1
+			logger.WriteError(metric.ExecutionGroup, e.Name, fmt.Errorf("engine API chain ID: expected '%d', got '%d'", e.chainID, chainID))
+		}
+	}
+
+	e.writeMetric(values)
+}
+
+func (e *EngineMetric) authHeader() http.Header {
+	return http.Header{
+		"Authorization": []string{"Bearer " + jwtToken(e.secret, time.Now())},
+	}
+}
+
+func (e *EngineMetric) writeMetric(values map[string]float64) {
+	e.AddDataPoint(values)
+
+	if values[EngineAuthFailureMeasurement] == 1 {
+		e.authFailures++
+		engineAuthFailuresMetric.With(serverAddrLabel(e.url)).Inc()
+	}
+	if values[EngineUnreachableMeasurement] == 1 {
+		e.unreachable++
+	}
+	if duration, ok := values[EngineDurationMeasurement]; ok {
+		e.durations = append(e.durations, duration)
+		engineDurationMetric.With(serverAddrLabel(e.url)).Observe(duration)
+		for method, v := range e.versions {
+			engineMethodVersionMetric.With(rpcMethodLabels(e.url, method)).Set(float64(v))
+		}
+	}
+
+	logValues := make(map[string]any, len(values))
+	for name, value := range values {
+		logValues[name] = value
+	}
+	logger.WriteMetric(metric.ExecutionGroup, e.Name, logValues)
+}
+
+func (e *EngineMetric) AggregateResults() string {
+	percentiles := metric.CalculatePercentiles(slices.Clone(e.durations), 0, 10, 50, 90, 100)
+
+	var versions []string
+	for _, method := range slices.Sorted(maps.Keys(e.versions)) {
+		versions = append(versions, fmt.Sprintf("%sV%d", method, e.versions[method]))
+	}
+
+	return fmt.Sprintf("auth_failures=%d, unreachable=%d \n duration_seconds: %s \n engine_methods=[%s]",
+		e.authFailures,
+		e.unreachable,
+		metric.FormatPercentiles(percentiles[0], percentiles[10], percentiles[50], percentiles[90], percentiles[100]),
+		strings.Join(versions, ", "))
+}
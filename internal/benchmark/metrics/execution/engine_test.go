@@ -0,0 +1,129 @@
+package execution
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+// jwtIssuedAt verifies the HS256 signature of the token and returns its 'iat' claim, the same way the execution client does
+func jwtIssuedAt(token string, secret []byte) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("token was not a valid JWT")
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+		return time.Time{}, errors.New("token signature was not valid")
+	}
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, err
+	}
+	var claims struct {
+		IssuedAt int64 `json:"iat"`
+	}
+	if err := json.Unmarshal(claimsJSON, &claims); err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(claims.IssuedAt, 0), nil
+}
+
+func newEngineStub(t *testing.T, secret []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issuedAt, err := jwtIssuedAt(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), secret)
+		if err != nil || time.Since(issuedAt).Abs() > time.Minute {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid token"}`))
+			return
+		}
+
+		var request struct {
+			Method string `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch request.Method {
+		case "engine_exchangeCapabilities":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":["engine_newPayloadV3","engine_newPayloadV4","engine_forkchoiceUpdatedV3","engine_getPayloadV4"]}`))
+		case "eth_chainId":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x4268"}`))
+		}
+	}))
+}
+
+func TestEngineMetric_Measure(t *testing.T) {
+	secretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(secretPath, []byte("0x"+strings.Repeat("ab", jwtSecretLength)+"\n"), 0o600))
+	secret, err := LoadJWTSecret(secretPath)
+	require.NoError(t, err)
+
+	server := newEngineStub(t, secret)
+	defer server.Close()
+
+	t.Run("valid secret", func(t *testing.T) {
+		engineMetric := NewEngineMetric(server.URL, "Engine", time.Second, secret, 1, nil)
+		engineMetric.measure(context.Background())
+
+		require.Len(t, engineMetric.DataPoints, 1)
+		values := engineMetric.DataPoints[0].Values
+		assert.Equal(t, 0.0, values[EngineAuthFailureMeasurement])
+		assert.Equal(t, 1.0, values[NetworkMismatchMeasurement])
+		assert.Contains(t, values, EngineDurationMeasurement)
+		assert.Equal(t, map[string]int{"engine_newPayload": 4, "engine_forkchoiceUpdated": 3, "engine_getPayload": 4}, engineMetric.versions)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		engineMetric := NewEngineMetric(server.URL, "Engine", time.Second, []byte(strings.Repeat("c", jwtSecretLength)), 0, nil)
+		engineMetric.measure(context.Background())
+
+		require.Len(t, engineMetric.DataPoints, 1)
+		values := engineMetric.DataPoints[0].Values
+		assert.Equal(t, 1.0, values[EngineAuthFailureMeasurement])
+		assert.NotContains(t, values, EngineDurationMeasurement)
+		assert.Equal(t, uint64(1), engineMetric.authFailures)
+	})
+}
+
+func TestCallWithHeader_WebSocket(t *testing.T) {
+	server := httptest.NewServer(websocket.Server{
+		Handshake: func(config *websocket.Config, r *http.Request) error {
+			if r.Header.Get("Authorization") != "Bearer valid" {
+				return errors.New("unauthorized")
+			}
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			_, _ = conn.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		},
+	})
+	defer server.Close()
+	url := strings.Replace(server.URL, "http://", "ws://", 1)
+
+	var chainID string
+	require.NoError(t, callWithHeader(context.Background(), url, requestTimeout, http.Header{"Authorization": []string{"Bearer valid"}}, "eth_chainId", nil, &chainID))
+	assert.Equal(t, "0x1", chainID)
+
+	err := callWithHeader(context.Background(), url, requestTimeout, http.Header{"Authorization": []string{"Bearer invalid"}}, "eth_chainId", nil, &chainID)
+	assert.ErrorIs(t, err, errUnauthorized)
+}
@@ -0,0 +1,113 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+const (
+	NetworkMismatchMeasurement = "Mismatch"
+)
+
+// NetworkMetric verifies that the execution client is on the expected network by comparing
+// the 'eth_chainId' and 'net_version' results with the chain ID of the expected network.
+type NetworkMetric struct {
+	metric.Base[uint32]
+	url        string
+	interval   time.Duration
+	expected   network.Network
+	mismatches []string
+}
+
+func NewNetworkMetric(url, name string, interval time.Duration, expected network.Network, healthCondition []metric.HealthCondition[uint32]) *NetworkMetric {
+	return &NetworkMetric{
+		url: url,
+		Base: metric.Base[uint32]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+		expected: expected,
+	}
+}
+
+func (n *NetworkMetric) Measure(ctx context.Context) {
+	n.measure(ctx)
+
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", n.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			n.measure(ctx)
+		}
+	}
+}
+
+func (n *NetworkMetric) measure(ctx context.Context) {
+	var chainIDHex, netVersion string
+	if err := call(ctx, n.url, "eth_chainId", nil, &chainIDHex); err != nil {
+		logger.WriteError(metric.ExecutionGroup, n.Name, errors.Join(err, errors.New("failed fetching chain ID")))
+		return
+	}
+	if err := call(ctx, n.url, "net_version", nil, &netVersion); err != nil {
+		logger.WriteError(metric.ExecutionGroup, n.Name, errors.Join(err, errors.New("failed fetching network version")))
+		return
+	}
+
+	chainID, err := parseHexUint(chainIDHex)
+	if err != nil {
+		logger.WriteError(metric.ExecutionGroup, n.Name, errors.Join(err, errors.New("failed parsing chain ID")))
+		return
+	}
+
+	var mismatches []string
+	if n.expected.ChainID != 0 {
+		if chainID != n.expected.ChainID {
+			mismatches = append(mismatches, fmt.Sprintf("chain ID: expected '%d', got '%d'", n.expected.ChainID, chainID))
+		}
+		// the network ID equals the chain ID on all supported networks
+		if netVersion != strconv.FormatUint(n.expected.ChainID, 10) {
+			mismatches = append(mismatches, fmt.Sprintf("network version: expected '%d', got '%s'", n.expected.ChainID, netVersion))
+		}
+	}
+
+	n.writeMetric(mismatches)
+}
+
+func (n *NetworkMetric) writeMetric(mismatches []string) {
+	n.mismatches = mismatches
+
+	n.AddDataPoint(map[string]uint32{
+		NetworkMismatchMeasurement: uint32(len(mismatches)),
+	})
+
+	networkMismatchMetric.With(serverAddrLabel(n.url)).Set(float64(len(mismatches)))
+
+	logger.WriteMetric(metric.ExecutionGroup, n.Name, map[string]any{
+		NetworkMismatchMeasurement: len(mismatches),
+	}, map[string]any{
+		"mismatches": strings.Join(mismatches, "; "),
+	})
+}
+
+func (n *NetworkMetric) AggregateResults() string {
+	if len(n.DataPoints) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("network=%s, mismatches=[%s]", n.expected.Name, strings.Join(n.mismatches, "; "))
+}
@@ -1,14 +1,9 @@
 package execution
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
-	"io"
 	"log/slog"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -57,60 +52,16 @@ func (p *PeerMetric) Measure(ctx context.Context) {
 }
 
 func (p *PeerMetric) measure(ctx context.Context) {
-	var (
-		resp struct {
-			Result string `json:"result"`
-		}
-	)
-
-	request := struct {
-		Jsonrpc string `json:"jsonrpc"`
-		Method  string `json:"method"`
-		Params  []any  `json:"params"`
-		ID      int    `json:"id"`
-	}{
-		Jsonrpc: "2.0",
-		Method:  "net_peerCount",
-		Params:  []any{},
-		ID:      1,
-	}
-
-	requestBytes, err := json.Marshal(request)
-	if err != nil {
-		logger.WriteError(metric.ExecutionGroup, p.Name, err)
-		return
-	}
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewBuffer(requestBytes))
-	req.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		logger.WriteError(metric.ExecutionGroup, p.Name, err)
-		return
-	}
-
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		p.writeMetric(0)
-		logger.WriteError(metric.ExecutionGroup, p.Name, err)
-		return
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		p.writeMetric(0)
-		p.logErrorResponse(res)
-		return
-	}
-
-	if err = json.NewDecoder(res.Body).Decode(&resp); err != nil {
+	var peerCountHex string
+	if err := call(ctx, p.url, "net_peerCount", nil, &peerCountHex); err != nil {
 		p.writeMetric(0)
 		logger.WriteError(metric.ExecutionGroup, p.Name, err)
+		if isMethodNotFound(err) {
+			p.measuringErrors[PeerCountMeasurement] = errors.Join(errUnableMeasure, err, errors.New("net_peerCount RPC method is not supported"))
+		}
 		return
 	}
 
-	peerCountHex := resp.Result
 	if peerCountHex == "" {
 		p.writeMetric(0)
 		err := errors.New("peer count RPC response was empty. Most likely net_peerCount RPC method is not supported")
@@ -129,44 +80,6 @@ func (p *PeerMetric) measure(ctx context.Context) {
 	p.writeMetric(peerCount)
 }
 
-func (p *PeerMetric) logErrorResponse(res *http.Response) {
-	var responseString string
-	if res.Header.Get("Content-Type") == "application/json" {
-		var errorResponse any
-		if err := json.NewDecoder(res.Body).Decode(&errorResponse); err != nil {
-			logger.WriteError(
-				metric.ExecutionGroup,
-				p.Name,
-				errors.Join(err, fmt.Errorf("received unsuccessful status code. Code: '%s'. Failed to JSON decode response", res.Status)))
-			return
-		}
-		jsonErrResponse, err := json.Marshal(errorResponse)
-		if err != nil {
-			logger.WriteError(
-				metric.ExecutionGroup,
-				p.Name,
-				errors.Join(err, fmt.Errorf("received unsuccessful status code. Code: '%s'. Failed to marshal response", res.Status)))
-			return
-		}
-		responseString = string(jsonErrResponse)
-	} else {
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			logger.WriteError(
-				metric.ExecutionGroup,
-				p.Name,
-				errors.Join(err, fmt.Errorf("received unsuccessful status code. Code: '%s'. Failed to decode response", res.Status)))
-			return
-		}
-		responseString = string(body)
-	}
-
-	logger.WriteError(
-		metric.ExecutionGroup,
-		p.Name,
-		fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, responseString))
-}
-
 func (p *PeerMetric) writeMetric(value int64) {
 	p.AddDataPoint(map[string]uint32{
 		PeerCountMeasurement: uint32(value),
@@ -3,6 +3,8 @@ package execution
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
 )
 
 const (
@@ -10,6 +12,11 @@ const (
 	subsystem = "execution"
 
 	serverAddrLabelName = "server_address"
+	methodLabelName     = "method"
+	codeLabelName       = "code"
+	versionLabelName    = "version"
+	statusLabelName     = "status"
+	consensusLabelName  = "consensus_address"
 )
 
 var (
@@ -23,6 +30,46 @@ var (
 		Subsystem: subsystem,
 	}, labels)
 
+	rpcDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:      "rpc_duration_seconds",
+		Help:      "histogram of successful JSON-RPC call durations in seconds",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		Namespace: namespace,
+		Subsystem: subsystem,
+	}, []string{serverAddrLabelName, methodLabelName})
+
+	rpcErrorsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "rpc_errors",
+			Help:      "number of failed JSON-RPC calls per JSON-RPC error code, timeout or request failure",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, methodLabelName, codeLabelName})
+
+	webSocketHandshakeMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:      "websocket_handshake_seconds",
+		Help:      "histogram of WebSocket connect and handshake durations in seconds",
+		Buckets:   prometheus.DefBuckets,
+		Namespace: namespace,
+		Subsystem: subsystem,
+	}, labels)
+
+	blockDelayMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:      "new_head_delay_seconds",
+		Help:      "histogram of delays between the block timestamp and the arrival of its newHeads notification in seconds",
+		Buckets:   []float64{0.5, 1, 2, 3, 4, 6, 8, 12, 24},
+		Namespace: namespace,
+		Subsystem: subsystem,
+	}, labels)
+
+	subscriptionDropsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "subscription_drops",
+			Help:      "number of dropped or failed newHeads subscriptions",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
 	peerCountMetric = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name:      "peer_count",
@@ -30,6 +77,94 @@ var (
 			Namespace: namespace,
 			Subsystem: subsystem,
 		}, labels)
+
+	syncingMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "syncing",
+			Help:      "set to 1 while the node reports that it is syncing",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	headAgeMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "head_age_seconds",
+			Help:      "seconds elapsed since the timestamp of the latest block of the node",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	headLagMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "head_lag_blocks",
+			Help:      "number of blocks the head of the node is behind the execution payload of the beacon head",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	engineDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:      "engine_duration_seconds",
+		Help:      "histogram of authenticated engine_exchangeCapabilities call durations in seconds",
+		Buckets:   prometheus.DefBuckets,
+		Namespace: namespace,
+		Subsystem: subsystem,
+	}, labels)
+
+	engineAuthFailuresMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "engine_auth_failures",
+			Help:      "number of engine API requests rejected because of an invalid JWT",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	engineMethodVersionMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "engine_method_version",
+			Help:      "highest version of the engine API method supported by the node",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, methodLabelName})
+
+	clientVersionMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "client_version",
+			Help:      "client version reported by the node along with its version policy status",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, versionLabelName, statusLabelName})
+
+	methodSupportedMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "rpc_method_supported",
+			Help:      "set to 1 when the JSON-RPC method required by the SSV node is available on the node",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, methodLabelName})
+
+	headMismatchMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "head_mismatch",
+			Help:      "set to 1 when the block of the node differs from the execution payload of the paired beacon node head",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, consensusLabelName})
+
+	pairBlockLagMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "pair_block_lag",
+			Help:      "number of blocks the head of the node is behind the execution payload of the paired beacon node head",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, consensusLabelName})
+
+	networkMismatchMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "network_mismatches",
+			Help:      "number of network parameters of the node not matching the expected network",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
 )
 
 func serverAddrLabel(serverAddr string) map[string]string {
@@ -37,3 +172,33 @@ func serverAddrLabel(serverAddr string) map[string]string {
 		serverAddrLabelName: serverAddr,
 	}
 }
+
+func rpcMethodLabels(serverAddr, method string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		methodLabelName:     method,
+	}
+}
+
+func rpcErrorLabels(serverAddr, method, code string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		methodLabelName:     method,
+		codeLabelName:       code,
+	}
+}
+
+func pairLabels(serverAddr, consensusAddr string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		consensusLabelName:  consensusAddr,
+	}
+}
+
+func clientVersionLabels(serverAddr, version string, status version.Status) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		versionLabelName:    version,
+		statusLabelName:     string(status),
+	}
+}
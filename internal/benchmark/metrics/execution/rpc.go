@@ -0,0 +1,140 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const requestTimeout = 5 * time.Second
+
+// errUnauthorized is returned when the node rejects the credentials of the request, e.g. an invalid Engine API JWT
+var errUnauthorized = errors.New("request was not authorized")
+
+type (
+	rpcRequest struct {
+		Jsonrpc string `json:"jsonrpc"`
+		Method  string `json:"method"`
+		Params  []any  `json:"params"`
+		ID      int    `json:"id"`
+	}
+
+	rpcResponse struct {
+		Result json.RawMessage `json:"result"`
+		Error  *rpcError       `json:"error"`
+	}
+
+	// rpcError is the error object of a JSON-RPC response, e.g. when the method is not supported by the client
+	rpcError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("JSON-RPC error. Code: '%d'. Message: '%s'", e.Code, e.Message)
+}
+
+// call executes a JSON-RPC request against the execution client over HTTP or WebSocket, depending on the URL scheme,
+// and decodes its result into resp. Errors returned by the client in the response body are returned as *rpcError.
+func call(ctx context.Context, url, method string, params []any, resp any) error {
+	return callWithTimeout(ctx, url, requestTimeout, method, params, resp)
+}
+
+func callWithTimeout(ctx context.Context, url string, timeout time.Duration, method string, params []any, resp any) error {
+	return callWithHeader(ctx, url, timeout, nil, method, params, resp)
+}
+
+// callWithHeader sends the header along with the request, e.g. the Engine API authorization. WebSocket requests send it
+// with the handshake.
+func callWithHeader(ctx context.Context, url string, timeout time.Duration, header http.Header, method string, params []any, resp any) error {
+	if params == nil {
+		params = []any{}
+	}
+
+	request := rpcRequest{
+		Jsonrpc: "2.0",
+		Method:  method,
+		Params:  params,
+		ID:      1,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		rpcResp rpcResponse
+		err     error
+	)
+	if isWebSocket(url) {
+		rpcResp, err = callWebSocket(ctx, url, header, request)
+	} else {
+		rpcResp, err = callHTTP(ctx, url, header, request)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+	if resp == nil {
+		return nil
+	}
+	if err := json.Unmarshal(rpcResp.Result, resp); err != nil {
+		return errors.Join(err, fmt.Errorf("failed decoding '%s' result", method))
+	}
+
+	return nil
+}
+
+func callHTTP(ctx context.Context, url string, header http.Header, request rpcRequest) (rpcResponse, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return rpcResponse{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBytes))
+	if err != nil {
+		return rpcResponse{}, err
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return rpcResponse{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized || res.StatusCode == http.StatusForbidden {
+		body, _ := io.ReadAll(res.Body)
+		return rpcResponse{}, errors.Join(errUnauthorized, fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, body))
+	}
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return rpcResponse{}, fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, body)
+	}
+
+	var rpcResp rpcResponse
+	if err := json.NewDecoder(res.Body).Decode(&rpcResp); err != nil {
+		return rpcResponse{}, errors.Join(err, fmt.Errorf("failed decoding '%s' response", request.Method))
+	}
+
+	return rpcResp, nil
+}
+
+func parseHexUint(value string) (uint64, error) {
+	return strconv.ParseUint(strings.TrimPrefix(value, "0x"), 16, 64)
+}
@@ -0,0 +1,236 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	BlockNumberMethod = "eth_blockNumber"
+	GetLogsMethod     = "eth_getLogs"
+	CallMethod        = "eth_call"
+
+	RPCDurationP90Measurement = "DurationP90"
+	RPCErrorsMeasurement      = "Errors"
+	RPCTimeoutsMeasurement    = "Timeouts"
+
+	// getVersionSelector is the selector of the 'getVersion()' view function of the SSV network contract
+	getVersionSelector = "0x0d8e6e2c"
+	timeoutErrorCode   = "timeout"
+	requestErrorCode   = "request"
+	// latencyWindow is the number of latest calls per method the measured P90 is calculated over, so that a slow stretch
+	// shows up in the percentile instead of being diluted by the rest of the run
+	latencyWindow = 60
+)
+
+var SupportedRPCMethods = []string{BlockNumberMethod, GetLogsMethod, CallMethod}
+
+// RPCMeasurement returns the name of a measurement of the given JSON-RPC method, e.g. 'eth_getLogs.DurationP90'
+func RPCMeasurement(method, measurement string) string {
+	return fmt.Sprintf("%s.%s", method, measurement)
+}
+
+// ValidateRPCMethods checks that all methods can be timed by the RPC latency metric
+func ValidateRPCMethods(methods []string) error {
+	var validationErr error
+	for _, method := range methods {
+		if !slices.Contains(SupportedRPCMethods, method) {
+			validationErr = errors.Join(validationErr, fmt.Errorf("unsupported RPC method: '%s'. List of supported methods: '%v'", method, SupportedRPCMethods))
+		}
+	}
+	return validationErr
+}
+
+// RPCLatencyMetric times the JSON-RPC calls the SSV node depends on. 'eth_getLogs' queries the SSV network contract
+// over the most recent blocks and 'eth_call' calls its 'getVersion()' view function. The measured P90 covers the latest
+// calls, the aggregated percentiles cover the whole run.
+type RPCLatencyMetric struct {
+	metric.Base[float64]
+	url       string
+	interval  time.Duration
+	timeout   time.Duration
+	methods   []string
+	contract  string
+	logsRange uint64
+	durations map[string][]time.Duration
+	windows   map[string][]time.Duration
+	errors    map[string]map[string]uint64
+}
+
+func NewRPCLatencyMetric(url, name string, interval, timeout time.Duration, methods []string, contract string, logsRange uint64, healthCondition []metric.HealthCondition[float64]) *RPCLatencyMetric {
+	return &RPCLatencyMetric{
+		url: url,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval:  interval,
+		timeout:   timeout,
+		methods:   methods,
+		contract:  contract,
+		logsRange: logsRange,
+		durations: make(map[string][]time.Duration),
+		windows:   make(map[string][]time.Duration),
+		errors:    make(map[string]map[string]uint64),
+	}
+}
+
+func (r *RPCLatencyMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", r.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			r.measure(ctx)
+		}
+	}
+}
+
+func (r *RPCLatencyMetric) measure(ctx context.Context) {
+	values := make(map[string]float64)
+	for _, method := range r.methods {
+		params, err := r.params(ctx, method)
+		if err != nil {
+			logger.WriteError(metric.ExecutionGroup, r.Name, errors.Join(err, fmt.Errorf("failed preparing '%s' params", method)))
+			continue
+		}
+		if params == nil {
+			continue
+		}
+
+		start := time.Now()
+		err = callWithTimeout(ctx, r.url, r.timeout, method, params, nil)
+		duration := time.Since(start)
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			code := errorCode(err)
+			if code == timeoutErrorCode {
+				values[RPCMeasurement(method, RPCTimeoutsMeasurement)]++
+			} else {
+				values[RPCMeasurement(method, RPCErrorsMeasurement)]++
+			}
+			if r.errors[method] == nil {
+				r.errors[method] = make(map[string]uint64)
+			}
+			r.errors[method][code]++
+			rpcErrorsMetric.With(rpcErrorLabels(r.url, method, code)).Inc()
+			logger.WriteError(metric.ExecutionGroup, r.Name, errors.Join(err, fmt.Errorf("'%s' call failed", method)))
+			continue
+		}
+
+		r.durations[method] = append(r.durations[method], duration)
+		r.windows[method] = append(r.windows[method], duration)
+		if len(r.windows[method]) > latencyWindow {
+			r.windows[method] = r.windows[method][len(r.windows[method])-latencyWindow:]
+		}
+		rpcDurationMetric.With(rpcMethodLabels(r.url, method)).Observe(duration.Seconds())
+		values[RPCMeasurement(method, RPCDurationP90Measurement)] = metric.CalculatePercentiles(slices.Clone(r.windows[method]), 90)[90].Seconds()
+	}
+
+	r.writeMetric(values)
+}
+
+// params returns the params of the method call, or nil if the method is not applicable, e.g. no SSV network contract is known
+func (r *RPCLatencyMetric) params(ctx context.Context, method string) ([]any, error) {
+	switch method {
+	case GetLogsMethod:
+		if r.contract == "" {
+			return nil, nil
+		}
+		var blockNumber string
+		if err := callWithTimeout(ctx, r.url, r.timeout, BlockNumberMethod, nil, &blockNumber); err != nil {
+			return nil, err
+		}
+		head, err := parseHexUint(blockNumber)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed parsing block number"))
+		}
+		var fromBlock uint64
+		if head > r.logsRange {
+			fromBlock = head - r.logsRange
+		}
+		return []any{map[string]any{
+			"address":   r.contract,
+			"fromBlock": fmt.Sprintf("0x%x", fromBlock),
+			"toBlock":   fmt.Sprintf("0x%x", head),
+		}}, nil
+	case CallMethod:
+		if r.contract == "" {
+			return nil, nil
+		}
+		return []any{map[string]any{
+			"to":   r.contract,
+			"data": getVersionSelector,
+		}, "latest"}, nil
+	default:
+		return []any{}, nil
+	}
+}
+
+// errorCode classifies a failed call by its JSON-RPC error code, a timeout or any other request failure
+func errorCode(err error) string {
+	var rpcErr *rpcError
+	switch {
+	case errors.As(err, &rpcErr):
+		return strconv.Itoa(rpcErr.Code)
+	case errors.Is(err, context.DeadlineExceeded):
+		return timeoutErrorCode
+	default:
+		return requestErrorCode
+	}
+}
+
+func (r *RPCLatencyMetric) writeMetric(values map[string]float64) {
+	if len(values) == 0 {
+		return
+	}
+
+	r.AddDataPoint(values)
+
+	logValues := make(map[string]any, len(values))
+	for name, value := range values {
+		logValues[name] = value
+	}
+	logger.WriteMetric(metric.ExecutionGroup, r.Name, logValues)
+}
+
+func (r *RPCLatencyMetric) AggregateResults() string {
+	var results []string
+	for _, method := range r.methods {
+		percentiles := metric.CalculatePercentiles(slices.Clone(r.durations[method]), 0, 10, 50, 90, 100)
+		result := fmt.Sprintf("%s: %s", method, metric.FormatPercentiles(
+			percentiles[0],
+			percentiles[10],
+			percentiles[50],
+			percentiles[90],
+			percentiles[100]))
+
+		if codes := r.errors[method]; len(codes) != 0 {
+			var errs []string
+			for _, code := range slices.Sorted(maps.Keys(codes)) {
+				errs = append(errs, fmt.Sprintf("%s: %d", code, codes[code]))
+			}
+			result += fmt.Sprintf(", errors=[%s]", strings.Join(errs, ", "))
+		}
+		results = append(results, result)
+	}
+
+	return strings.Join(results, " \n ")
+}
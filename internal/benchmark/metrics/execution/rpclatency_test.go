@@ -0,0 +1,77 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCLatencyMetric_Measure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Method string           `json:"method"`
+			Params []map[string]any `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch request.Method {
+		case BlockNumberMethod:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1000"}`))
+		case GetLogsMethod:
+			assert.Equal(t, "0xc18", request.Params[0]["fromBlock"])
+			assert.Equal(t, "0x1000", request.Params[0]["toBlock"])
+			time.Sleep(200 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":[]}`))
+		case CallMethod:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"execution reverted"}}`))
+		}
+	}))
+	defer server.Close()
+
+	metric := NewRPCLatencyMetric(server.URL, "RPC", time.Second, 100*time.Millisecond, SupportedRPCMethods, "0x01", 1000, nil)
+	metric.measure(context.Background())
+
+	require.Len(t, metric.DataPoints, 1)
+	values := metric.DataPoints[0].Values
+	assert.Contains(t, values, RPCMeasurement(BlockNumberMethod, RPCDurationP90Measurement))
+	assert.Equal(t, 1.0, values[RPCMeasurement(GetLogsMethod, RPCTimeoutsMeasurement)])
+	assert.Equal(t, 1.0, values[RPCMeasurement(CallMethod, RPCErrorsMeasurement)])
+	assert.Equal(t, map[string]uint64{timeoutErrorCode: 1}, metric.errors[GetLogsMethod])
+	assert.Equal(t, map[string]uint64{"-32000": 1}, metric.errors[CallMethod])
+	assert.Len(t, metric.durations[BlockNumberMethod], 1)
+}
+
+func TestRPCLatencyMetric_Window(t *testing.T) {
+	var slow atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slow.Load() {
+			time.Sleep(50 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1000"}`))
+	}))
+	defer server.Close()
+
+	metric := NewRPCLatencyMetric(server.URL, "RPC", time.Second, time.Second, []string{BlockNumberMethod}, "", 1000, nil)
+	slow.Store(true)
+	for range latencyWindow / 2 {
+		metric.measure(context.Background())
+	}
+	slow.Store(false)
+	for range latencyWindow {
+		metric.measure(context.Background())
+	}
+
+	p90 := metric.DataPoints[len(metric.DataPoints)-1].Values[RPCMeasurement(BlockNumberMethod, RPCDurationP90Measurement)]
+	assert.Less(t, p90, (50 * time.Millisecond).Seconds())
+	assert.Len(t, metric.windows[BlockNumberMethod], latencyWindow)
+	assert.Len(t, metric.durations[BlockNumberMethod], latencyWindow*3/2)
+}
@@ -0,0 +1,155 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/consensus"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	SyncingMeasurement = "Syncing"
+	HeadAgeMeasurement = "HeadAge"
+	HeadLagMeasurement = "HeadLag"
+)
+
+// SyncMetric reports whether the execution client is syncing, the age of its head block
+// and how many blocks its head is behind the execution payload of the beacon head.
+type SyncMetric struct {
+	metric.Base[float64]
+	url           string
+	consensusURLs []string
+	interval      time.Duration
+}
+
+func NewSyncMetric(url string, consensusURLs []string, name string, interval time.Duration, healthCondition []metric.HealthCondition[float64]) *SyncMetric {
+	return &SyncMetric{
+		url:           url,
+		consensusURLs: consensusURLs,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (s *SyncMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", s.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			s.measure(ctx)
+		}
+	}
+}
+
+func (s *SyncMetric) measure(ctx context.Context) {
+	var (
+		syncing     json.RawMessage
+		blockNumber string
+		block       struct {
+			Timestamp string `json:"timestamp"`
+		}
+	)
+
+	if err := call(ctx, s.url, "eth_syncing", nil, &syncing); err != nil {
+		logger.WriteError(metric.ExecutionGroup, s.Name, errors.Join(err, errors.New("failed fetching sync status")))
+		return
+	}
+	if err := call(ctx, s.url, "eth_blockNumber", nil, &blockNumber); err != nil {
+		logger.WriteError(metric.ExecutionGroup, s.Name, errors.Join(err, errors.New("failed fetching block number")))
+		return
+	}
+	if err := call(ctx, s.url, "eth_getBlockByNumber", []any{"latest", false}, &block); err != nil {
+		logger.WriteError(metric.ExecutionGroup, s.Name, errors.Join(err, errors.New("failed fetching latest block")))
+		return
+	}
+
+	headNumber, err := parseHexUint(blockNumber)
+	if err != nil {
+		logger.WriteError(metric.ExecutionGroup, s.Name, errors.Join(err, errors.New("failed parsing block number")))
+		return
+	}
+	headTimestamp, err := parseHexUint(block.Timestamp)
+	if err != nil {
+		logger.WriteError(metric.ExecutionGroup, s.Name, errors.Join(err, errors.New("failed parsing latest block timestamp")))
+		return
+	}
+
+	values := map[string]float64{
+		// 'eth_syncing' returns false once the client is synced and an object with the sync progress otherwise
+		SyncingMeasurement: 0,
+		HeadAgeMeasurement: time.Since(time.Unix(int64(headTimestamp), 0)).Round(time.Millisecond).Seconds(),
+	}
+	if string(syncing) != "false" {
+		values[SyncingMeasurement] = 1
+	}
+
+	if len(s.consensusURLs) != 0 {
+		payload, err := consensus.FetchExecutionPayload(ctx, s.consensusURLs, "head")
+		if err != nil {
+			logger.WriteError(metric.ExecutionGroup, s.Name, errors.Join(err, errors.New("failed fetching execution payload of the beacon head")))
+		} else {
+			var lag float64
+			if payload.BlockNumber > headNumber {
+				lag = float64(payload.BlockNumber - headNumber)
+			}
+			values[HeadLagMeasurement] = lag
+		}
+	}
+
+	s.writeMetric(values)
+}
+
+func (s *SyncMetric) writeMetric(values map[string]float64) {
+	s.AddDataPoint(values)
+
+	syncingMetric.With(serverAddrLabel(s.url)).Set(values[SyncingMeasurement])
+	headAgeMetric.With(serverAddrLabel(s.url)).Set(values[HeadAgeMeasurement])
+	if lag, ok := values[HeadLagMeasurement]; ok {
+		headLagMetric.With(serverAddrLabel(s.url)).Set(lag)
+	}
+
+	logValues := make(map[string]any, len(values))
+	for name, value := range values {
+		logValues[name] = value
+	}
+	logger.WriteMetric(metric.ExecutionGroup, s.Name, logValues)
+}
+
+func (s *SyncMetric) AggregateResults() string {
+	var (
+		ages, lags []float64
+		syncing    int
+	)
+	for _, point := range s.DataPoints {
+		ages = append(ages, point.Values[HeadAgeMeasurement])
+		if lag, ok := point.Values[HeadLagMeasurement]; ok {
+			lags = append(lags, lag)
+		}
+		if point.Values[SyncingMeasurement] == 1 {
+			syncing++
+		}
+	}
+
+	agePercentiles := metric.CalculatePercentiles(ages, 0, 10, 50, 90, 100)
+	lagPercentiles := metric.CalculatePercentiles(lags, 0, 10, 50, 90, 100)
+
+	return fmt.Sprintf("syncing=%d/%d \n head_age_seconds: %s \n head_lag_blocks: %s",
+		syncing,
+		len(s.DataPoints),
+		metric.FormatPercentiles(agePercentiles[0], agePercentiles[10], agePercentiles[50], agePercentiles[90], agePercentiles[100]),
+		metric.FormatPercentiles(lagPercentiles[0], lagPercentiles[10], lagPercentiles[50], lagPercentiles[90], lagPercentiles[100]))
+}
@@ -0,0 +1,53 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncMetric_Measure(t *testing.T) {
+	headTimestamp := time.Now().Add(-time.Minute).Unix()
+	executionServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request rpcRequest
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&request)) {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch request.Method {
+		case "eth_syncing":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"currentBlock":"0x64","highestBlock":"0x6e"}}`))
+		case "eth_blockNumber":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x64"}`))
+		case "eth_getBlockByNumber":
+			_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x64","timestamp":"0x%x"}}`, headTimestamp)
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`))
+		}
+	}))
+	defer executionServer.Close()
+
+	consensusServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v2/beacon/blocks/head", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"message":{"body":{"execution_payload":{"block_number":"110","block_hash":"0x01","timestamp":"0"}}}}}`))
+	}))
+	defer consensusServer.Close()
+
+	metric := NewSyncMetric(executionServer.URL, []string{consensusServer.URL}, "Sync", time.Second, nil)
+	metric.measure(context.Background())
+
+	require.Len(t, metric.DataPoints, 1)
+	values := metric.DataPoints[0].Values
+	assert.Equal(t, 1.0, values[SyncingMeasurement])
+	assert.Equal(t, 10.0, values[HeadLagMeasurement])
+	assert.InDelta(t, 60.0, values[HeadAgeMeasurement], 2)
+}
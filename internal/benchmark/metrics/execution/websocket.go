@@ -0,0 +1,243 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	HandshakeDurationMeasurement = "HandshakeDuration"
+	BlockDelayMeasurement        = "BlockDelay"
+	SubscriptionDropMeasurement  = "SubscriptionDrop"
+
+	// webSocketOrigin is accepted by execution clients which do not allow any other origin by default
+	webSocketOrigin = "http://localhost"
+)
+
+func isWebSocket(url string) bool {
+	return strings.HasPrefix(url, "ws://") || strings.HasPrefix(url, "wss://")
+}
+
+// dialWebSocket sends the header along with the handshake. A handshake rejected while sending an authorization header
+// is reported as errUnauthorized, the WebSocket client does not expose the status code of the rejection.
+func dialWebSocket(ctx context.Context, url string, header http.Header) (*websocket.Conn, error) {
+	config, err := websocket.NewConfig(url, webSocketOrigin)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		for _, value := range values {
+			config.Header.Add(key, value)
+		}
+	}
+
+	conn, err := config.DialContext(ctx)
+	var dialErr *websocket.DialError
+	if errors.As(err, &dialErr) && dialErr.Err == websocket.ErrBadStatus && header.Get("Authorization") != "" {
+		return nil, errors.Join(errUnauthorized, err)
+	}
+	return conn, err
+}
+
+// callWebSocket executes a single JSON-RPC request over a new WebSocket connection, so the measured
+// duration of the call includes the WebSocket handshake.
+func callWebSocket(ctx context.Context, url string, header http.Header, request rpcRequest) (rpcResponse, error) {
+	conn, err := dialWebSocket(ctx, url, header)
+	if err != nil {
+		return rpcResponse{}, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return rpcResponse{}, err
+		}
+	}
+
+	if err := websocket.JSON.Send(conn, request); err != nil {
+		return rpcResponse{}, err
+	}
+
+	var response rpcResponse
+	if err := websocket.JSON.Receive(conn, &response); err != nil {
+		if ctx.Err() != nil {
+			return rpcResponse{}, errors.Join(err, ctx.Err())
+		}
+		return rpcResponse{}, err
+	}
+
+	return response, nil
+}
+
+// WebSocketMetric keeps a 'newHeads' subscription open the same way the SSV node does, measuring the WebSocket handshake
+// duration and the delay between the block timestamp and the arrival of its header. A subscription that fails or stays silent
+// for longer than the head timeout is reported as a drop and re-established.
+type WebSocketMetric struct {
+	metric.Base[float64]
+	url              string
+	reconnectBackoff time.Duration
+	headTimeout      time.Duration
+	mu               sync.Mutex
+	handshakes       []float64
+	delays           []float64
+	drops            uint64
+}
+
+func NewWebSocketMetric(url, name string, reconnectBackoff, headTimeout time.Duration, healthCondition []metric.HealthCondition[float64]) *WebSocketMetric {
+	return &WebSocketMetric{
+		url: url,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		reconnectBackoff: reconnectBackoff,
+		headTimeout:      headTimeout,
+	}
+}
+
+func (w *WebSocketMetric) Measure(ctx context.Context) {
+	for {
+		err := w.subscribe(ctx)
+		if ctx.Err() != nil {
+			slog.With("metric_name", w.Name).Debug("metric was stopped")
+			return
+		}
+		w.writeDrop(err)
+
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", w.Name).Debug("metric was stopped")
+			return
+		case <-time.After(w.reconnectBackoff):
+		}
+	}
+}
+
+// subscribe connects to the execution client and reads 'newHeads' notifications until the subscription fails
+func (w *WebSocketMetric) subscribe(ctx context.Context) error {
+	dialCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	start := time.Now()
+	conn, err := dialWebSocket(dialCtx, w.url, nil)
+	cancel()
+	if err != nil {
+		return errors.Join(err, errors.New("failed connecting to WebSocket endpoint"))
+	}
+	defer conn.Close()
+
+	w.writeHandshake(time.Since(start))
+
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	if err := websocket.JSON.Send(conn, rpcRequest{
+		Jsonrpc: "2.0",
+		Method:  "eth_subscribe",
+		Params:  []any{"newHeads"},
+		ID:      1,
+	}); err != nil {
+		return errors.Join(err, errors.New("failed sending newHeads subscription request"))
+	}
+
+	for {
+		if err := conn.SetReadDeadline(time.Now().Add(w.headTimeout)); err != nil {
+			return err
+		}
+
+		var message struct {
+			rpcResponse
+			Method string `json:"method"`
+			Params struct {
+				Result struct {
+					Number    string `json:"number"`
+					Timestamp string `json:"timestamp"`
+				} `json:"result"`
+			} `json:"params"`
+		}
+		if err := websocket.JSON.Receive(conn, &message); err != nil {
+			return errors.Join(err, errors.New("newHeads subscription was dropped"))
+		}
+		if message.Error != nil {
+			return errors.Join(message.Error, errors.New("newHeads subscription was rejected"))
+		}
+		if message.Method != "eth_subscription" {
+			continue
+		}
+
+		timestamp, err := parseHexUint(message.Params.Result.Timestamp)
+		if err != nil {
+			logger.WriteError(metric.ExecutionGroup, w.Name, errors.Join(err, errors.New("failed parsing head timestamp")))
+			continue
+		}
+		w.writeBlockDelay(message.Params.Result.Number, time.Since(time.Unix(int64(timestamp), 0)))
+	}
+}
+
+func (w *WebSocketMetric) writeHandshake(duration time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.handshakes = append(w.handshakes, duration.Seconds())
+	w.AddDataPoint(map[string]float64{
+		HandshakeDurationMeasurement: duration.Seconds(),
+	})
+	webSocketHandshakeMetric.With(serverAddrLabel(w.url)).Observe(duration.Seconds())
+
+	logger.WriteMetric(metric.ExecutionGroup, w.Name, map[string]any{
+		HandshakeDurationMeasurement: duration,
+	})
+}
+
+func (w *WebSocketMetric) writeBlockDelay(blockNumber string, delay time.Duration) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.delays = append(w.delays, delay.Seconds())
+	w.AddDataPoint(map[string]float64{
+		BlockDelayMeasurement: delay.Seconds(),
+	})
+	blockDelayMetric.With(serverAddrLabel(w.url)).Observe(delay.Seconds())
+
+	logger.WriteMetric(metric.ExecutionGroup, w.Name, map[string]any{
+		BlockDelayMeasurement: delay,
+	}, map[string]any{
+		"block_number": blockNumber,
+	})
+}
+
+func (w *WebSocketMetric) writeDrop(err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.drops++
+	w.AddDataPoint(map[string]float64{
+		SubscriptionDropMeasurement: 1,
+	})
+	subscriptionDropsMetric.With(serverAddrLabel(w.url)).Inc()
+
+	logger.WriteError(metric.ExecutionGroup, w.Name, err)
+}
+
+func (w *WebSocketMetric) AggregateResults() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	handshakes := metric.CalculatePercentiles(w.handshakes, 0, 10, 50, 90, 100)
+	delays := metric.CalculatePercentiles(w.delays, 0, 10, 50, 90, 100)
+
+	return fmt.Sprintf("drops=%d, heads=%d \n handshake_seconds: %s \n block_delay_seconds: %s",
+		w.drops,
+		len(w.delays),
+		metric.FormatPercentiles(handshakes[0], handshakes[10], handshakes[50], handshakes[90], handshakes[100]),
+		metric.FormatPercentiles(delays[0], delays[10], delays[50], delays[90], delays[100]))
+}
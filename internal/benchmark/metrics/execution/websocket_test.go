@@ -0,0 +1,60 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func TestWebSocketMetric_Subscribe(t *testing.T) {
+	headTimestamp := time.Now().Add(-2 * time.Second).Unix()
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		var request rpcRequest
+		if !assert.NoError(t, websocket.JSON.Receive(conn, &request)) {
+			return
+		}
+		assert.Equal(t, "eth_subscribe", request.Method)
+		assert.Equal(t, []any{"newHeads"}, request.Params)
+
+		_, _ = conn.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0xcd0c3e8af590364c09d0fa6a1210faf5"}`))
+		for i := range 2 {
+			_, _ = fmt.Fprintf(conn, `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0xcd0c3e8af590364c09d0fa6a1210faf5","result":{"number":"0x%x","timestamp":"0x%x"}}}`, 100+i, headTimestamp)
+		}
+		// the connection is closed by the handler returning, which the metric must detect as a drop
+	}))
+	defer server.Close()
+
+	metric := NewWebSocketMetric(strings.Replace(server.URL, "http://", "ws://", 1), "WebSocket", time.Second, time.Second*12, nil)
+	err := metric.subscribe(context.Background())
+	require.ErrorContains(t, err, "newHeads subscription was dropped")
+	metric.writeDrop(err)
+
+	assert.Len(t, metric.handshakes, 1)
+	require.Len(t, metric.delays, 2)
+	assert.InDelta(t, 2.0, metric.delays[0], 1.5)
+	assert.Equal(t, uint64(1), metric.drops)
+	assert.Contains(t, metric.AggregateResults(), "drops=1, heads=2")
+}
+
+func TestCall_WebSocket(t *testing.T) {
+	server := httptest.NewServer(websocket.Handler(func(conn *websocket.Conn) {
+		var request rpcRequest
+		if !assert.NoError(t, websocket.JSON.Receive(conn, &request)) {
+			return
+		}
+		assert.Equal(t, "eth_chainId", request.Method)
+		_, _ = conn.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer server.Close()
+
+	var chainID string
+	require.NoError(t, call(context.Background(), strings.Replace(server.URL, "http://", "ws://", 1), "eth_chainId", nil, &chainID))
+	assert.Equal(t, "0x1", chainID)
+}
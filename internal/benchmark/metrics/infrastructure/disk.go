@@ -0,0 +1,117 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	FreeSpaceMeasurement  = "FreeSpace"
+	FreeInodesMeasurement = "FreeInodes"
+)
+
+// DiskMetric reports the free space and the free inodes of the filesystem containing the path, in percent
+type DiskMetric struct {
+	metric.Base[float64]
+	path      string
+	interval  time.Duration
+	freeBytes uint64
+}
+
+func NewDiskMetric(path, name string, interval time.Duration, healthCondition []metric.HealthCondition[float64]) *DiskMetric {
+	return &DiskMetric{
+		path: path,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (d *DiskMetric) Measure(ctx context.Context) {
+	d.measure()
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", d.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			d.measure()
+		}
+	}
+}
+
+func (d *DiskMetric) measure() {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(d.path, &stat); err != nil {
+		logger.WriteError(metric.InfrastructureGroup, d.Name, err)
+		return
+	}
+
+	// available blocks exclude the blocks reserved for the root user, which the nodes usually cannot use
+	total := uint64(stat.Blocks) * uint64(stat.Bsize)
+	free := uint64(stat.Bavail) * uint64(stat.Bsize)
+
+	values := make(map[string]float64)
+	if total != 0 {
+		values[FreeSpaceMeasurement] = float64(free) / float64(total) * 100
+	}
+	// filesystems allocating inodes dynamically, e.g. btrfs, report no inodes
+	if stat.Files != 0 {
+		values[FreeInodesMeasurement] = float64(stat.Ffree) / float64(stat.Files) * 100
+	}
+
+	d.writeMetric(free, total, values)
+}
+
+func (d *DiskMetric) writeMetric(free, total uint64, values map[string]float64) {
+	d.freeBytes = free
+	d.AddDataPoint(values)
+
+	diskFreeMetric.With(prometheus.Labels{pathLabel: d.path, diskResourceLabel: "space"}).Set(values[FreeSpaceMeasurement])
+	if inodes, ok := values[FreeInodesMeasurement]; ok {
+		diskFreeMetric.With(prometheus.Labels{pathLabel: d.path, diskResourceLabel: "inodes"}).Set(inodes)
+	}
+
+	logger.WriteMetric(metric.InfrastructureGroup, d.Name, map[string]any{
+		FreeSpaceMeasurement:  values[FreeSpaceMeasurement],
+		FreeInodesMeasurement: values[FreeInodesMeasurement],
+	}, map[string]any{
+		"path":        d.path,
+		"free_bytes":  free,
+		"total_bytes": total,
+	})
+}
+
+func (d *DiskMetric) AggregateResults() string {
+	if len(d.DataPoints) == 0 {
+		return ""
+	}
+
+	var space, inodes []float64
+	for _, point := range d.DataPoints {
+		space = append(space, point.Values[FreeSpaceMeasurement])
+		if value, ok := point.Values[FreeInodesMeasurement]; ok {
+			inodes = append(inodes, value)
+		}
+	}
+
+	result := fmt.Sprintf("free_space_min=%.2f%%, free=%.2fMB", metric.CalculatePercentiles(space, 0)[0], toMegabytes(d.freeBytes))
+	if len(inodes) != 0 {
+		result += fmt.Sprintf(", free_inodes_min=%.2f%%", metric.CalculatePercentiles(inodes, 0)[0])
+	}
+
+	return result
+}
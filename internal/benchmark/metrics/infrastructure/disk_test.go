@@ -0,0 +1,57 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskMetric_Measure(t *testing.T) {
+	disk := NewDiskMetric(t.TempDir(), "Disk", time.Minute, nil)
+	disk.measure()
+
+	require.Len(t, disk.DataPoints, 1)
+	freeSpace := disk.DataPoints[0].Values[FreeSpaceMeasurement]
+	assert.Greater(t, freeSpace, 0.0)
+	assert.LessOrEqual(t, freeSpace, 100.0)
+	assert.Contains(t, disk.AggregateResults(), "free_space_min=")
+
+	missing := NewDiskMetric(filepath.Join(t.TempDir(), "missing"), "Disk", time.Minute, nil)
+	missing.measure()
+	assert.Empty(t, missing.DataPoints)
+}
+
+func TestDiskLatencyMetric_Measure(t *testing.T) {
+	path := t.TempDir()
+	latency := NewDiskLatencyMetric(path, "Disk Latency", time.Second, nil)
+	for range 3 {
+		latency.measure()
+	}
+
+	require.Len(t, latency.DataPoints, 3)
+	values := latency.DataPoints[2].Values
+	assert.Greater(t, values[FsyncMaxMeasurement], time.Duration(0))
+	assert.LessOrEqual(t, values[FsyncP50Measurement], values[FsyncP99Measurement])
+	assert.Contains(t, latency.AggregateResults(), "probes=3")
+
+	info, err := os.Stat(filepath.Join(path, probeFileName))
+	require.NoError(t, err)
+	assert.Equal(t, int64(probeSize), info.Size())
+}
+
+func TestDiskLatencyMetric_Window(t *testing.T) {
+	latency := NewDiskLatencyMetric(t.TempDir(), "Disk Latency", time.Second, nil)
+	latency.durations = []time.Duration{time.Second}
+	latency.window = []time.Duration{time.Second}
+	for range probeWindow {
+		latency.measure()
+	}
+
+	require.Len(t, latency.window, probeWindow)
+	assert.Less(t, latency.DataPoints[len(latency.DataPoints)-1].Values[FsyncMaxMeasurement], time.Second)
+	assert.Contains(t, latency.AggregateResults(), "fsync_max=1s")
+}
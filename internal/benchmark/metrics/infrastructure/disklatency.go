@@ -0,0 +1,156 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	FsyncP50Measurement = "FsyncP50"
+	FsyncP90Measurement = "FsyncP90"
+	FsyncP99Measurement = "FsyncP99"
+	FsyncMaxMeasurement = "FsyncMax"
+
+	probeFileName = ".ssv-pulse-disk-probe"
+	probeSize     = 4096
+	// probeWindow is the number of latest probes the measured percentiles are calculated over, so that a slow stretch shows up
+	// instead of being diluted by the probes of the whole run. The evaluated severity is still the worst one of the run.
+	probeWindow = 60
+)
+
+// DiskLatencyMetric writes a small block to a scratch file in the path, syncs it to the disk and reads it back. The fsync
+// duration percentiles reflect the latency of the database commits of the nodes storing their data in the same path.
+// The measured percentiles cover the latest probes, the aggregated ones cover the whole run.
+type DiskLatencyMetric struct {
+	metric.Base[time.Duration]
+	path      string
+	interval  time.Duration
+	window    []time.Duration
+	durations []time.Duration
+}
+
+func NewDiskLatencyMetric(path, name string, interval time.Duration, healthCondition []metric.HealthCondition[time.Duration]) *DiskLatencyMetric {
+	return &DiskLatencyMetric{
+		path: path,
+		Base: metric.Base[time.Duration]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (d *DiskLatencyMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	defer os.Remove(filepath.Join(d.path, probeFileName))
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", d.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			d.measure()
+		}
+	}
+}
+
+func (d *DiskLatencyMetric) measure() {
+	duration, err := d.probe()
+	if err != nil {
+		logger.WriteError(metric.InfrastructureGroup, d.Name, errors.Join(err, errors.New("failed probing disk latency")))
+		return
+	}
+
+	d.durations = append(d.durations, duration)
+	d.window = append(d.window, duration)
+	if len(d.window) > probeWindow {
+		d.window = d.window[len(d.window)-probeWindow:]
+	}
+
+	d.writeMetric(duration)
+}
+
+// probe returns the duration of the fsync of a block written to the scratch file
+func (d *DiskLatencyMetric) probe() (time.Duration, error) {
+	block := make([]byte, probeSize)
+	if _, err := rand.Read(block); err != nil {
+		return 0, err
+	}
+
+	file, err := os.OpenFile(filepath.Join(d.path, probeFileName), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	if _, err := file.WriteAt(block, 0); err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	if err := file.Sync(); err != nil {
+		return 0, err
+	}
+	duration := time.Since(start)
+
+	// the block is served from the page cache, reading it back only verifies the write
+	read := make([]byte, probeSize)
+	if _, err := file.ReadAt(read, 0); err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(block, read) {
+		return 0, errors.New("block read from the scratch file did not match the written block")
+	}
+
+	return duration, nil
+}
+
+func (d *DiskLatencyMetric) writeMetric(duration time.Duration) {
+	// the percentiles are calculated over a copy, as sorting the window would drop the wrong probes once it is full
+	percentiles := metric.CalculatePercentiles(slices.Clone(d.window), 50, 90, 99, 100)
+
+	d.AddDataPoint(map[string]time.Duration{
+		FsyncP50Measurement: percentiles[50],
+		FsyncP90Measurement: percentiles[90],
+		FsyncP99Measurement: percentiles[99],
+		FsyncMaxMeasurement: percentiles[100],
+	})
+
+	fsyncDurationMetric.With(prometheus.Labels{pathLabel: d.path}).Observe(duration.Seconds())
+
+	logger.WriteMetric(metric.InfrastructureGroup, d.Name, map[string]any{
+		FsyncP50Measurement: percentiles[50],
+		FsyncP90Measurement: percentiles[90],
+		FsyncP99Measurement: percentiles[99],
+		FsyncMaxMeasurement: percentiles[100],
+	}, map[string]any{
+		"path": d.path,
+	})
+}
+
+func (d *DiskLatencyMetric) AggregateResults() string {
+	if len(d.DataPoints) == 0 {
+		return ""
+	}
+
+	percentiles := metric.CalculatePercentiles(d.durations, 50, 90, 99, 100)
+	return fmt.Sprintf("fsync_P50=%s, fsync_P90=%s, fsync_P99=%s, fsync_max=%s, probes=%d",
+		percentiles[50],
+		percentiles[90],
+		percentiles[99],
+		percentiles[100],
+		len(d.durations))
+}
@@ -0,0 +1,252 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	RxBandwidthMeasurement = "RxBandwidth"
+	TxBandwidthMeasurement = "TxBandwidth"
+	UtilizationMeasurement = "Utilization"
+	DropsMeasurement       = "Drops"
+	ErrorsMeasurement      = "Errors"
+
+	procNetDevPath    = "/proc/net/dev"
+	sysClassNetPath   = "/sys/class/net"
+	loopbackInterface = "lo"
+	bitsPerMegabit    = 1_000_000
+	bytesToBitsRatio  = 8
+)
+
+type interfaceCounters struct {
+	rxBytes, rxErrors, rxDrops uint64
+	txBytes, txErrors, txDrops uint64
+}
+
+// readInterfaceCounters parses the counters of every interface listed in '/proc/net/dev'
+func readInterfaceCounters(path string) (map[string]interfaceCounters, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	counters := make(map[string]interfaceCounters)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		iface, stats, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			// header lines
+			continue
+		}
+		fields := strings.Fields(stats)
+		if len(fields) < 12 {
+			return nil, fmt.Errorf("interface: '%s' had %d fields, expected at least 12", strings.TrimSpace(iface), len(fields))
+		}
+		var values [12]uint64
+		for i := range values {
+			if values[i], err = strconv.ParseUint(fields[i], 10, 64); err != nil {
+				return nil, errors.Join(err, fmt.Errorf("failed parsing counters of interface: '%s'", strings.TrimSpace(iface)))
+			}
+		}
+		// receive: bytes packets errs drop fifo frame compressed multicast, transmit: bytes packets errs drop ...
+		counters[strings.TrimSpace(iface)] = interfaceCounters{
+			rxBytes:  values[0],
+			rxErrors: values[2],
+			rxDrops:  values[3],
+			txBytes:  values[8],
+			txErrors: values[10],
+			txDrops:  values[11],
+		}
+	}
+
+	return counters, scanner.Err()
+}
+
+// NetworkInterfaces lists the physical interfaces of the host. Virtual interfaces, e.g. the loopback, bridge and veth
+// interfaces, have no backing device and are left out. Hosts without physical interfaces, e.g. containers and some VMs,
+// fall back to all interfaces but the loopback.
+func NetworkInterfaces() ([]string, error) {
+	return physicalInterfaces(procNetDevPath, sysClassNetPath)
+}
+
+func physicalInterfaces(devPath, sysPath string) ([]string, error) {
+	counters, err := readInterfaceCounters(devPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces, nonLoopback []string
+	for iface := range counters {
+		if iface != loopbackInterface {
+			nonLoopback = append(nonLoopback, iface)
+		}
+		if _, err := os.Stat(filepath.Join(sysPath, iface, "device")); err == nil {
+			ifaces = append(ifaces, iface)
+		}
+	}
+	if len(ifaces) == 0 {
+		slog.
+			With("interfaces", nonLoopback).
+			Warn("no physical network interfaces were found, measuring all interfaces but the loopback")
+		return nonLoopback, nil
+	}
+	return ifaces, nil
+}
+
+// NetworkMetric reports the receive and transmit bandwidth of the interface in Mbit/s along with the packets dropped and the
+// errors since the previous measurement. The utilization is the busier direction in percent of the link speed, it is not
+// reported for interfaces without a link speed, e.g. virtual interfaces.
+type NetworkMetric struct {
+	metric.Base[float64]
+	iface        string
+	devPath      string
+	speedPath    string
+	interval     time.Duration
+	prev         interfaceCounters
+	prevTime     time.Time
+	linkSpeedMbs float64
+}
+
+func NewNetworkMetric(iface, name string, interval time.Duration, healthCondition []metric.HealthCondition[float64]) *NetworkMetric {
+	return &NetworkMetric{
+		iface:     iface,
+		devPath:   procNetDevPath,
+		speedPath: filepath.Join(sysClassNetPath, iface, "speed"),
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (n *NetworkMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", n.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			n.measure()
+		}
+	}
+}
+
+func (n *NetworkMetric) measure() {
+	counters, err := readInterfaceCounters(n.devPath)
+	if err != nil {
+		logger.WriteError(metric.InfrastructureGroup, n.Name, err)
+		return
+	}
+	current, ok := counters[n.iface]
+	if !ok {
+		logger.WriteError(metric.InfrastructureGroup, n.Name, fmt.Errorf("interface: '%s' was not found", n.iface))
+		return
+	}
+	now := time.Now()
+
+	prev, prevTime := n.prev, n.prevTime
+	n.prev, n.prevTime = current, now
+	// the first measurement and counters reset by the interface only become the baseline of the next measurement
+	if prevTime.IsZero() || current.rxBytes < prev.rxBytes || current.txBytes < prev.txBytes {
+		return
+	}
+
+	elapsed := now.Sub(prevTime).Seconds()
+	values := map[string]float64{
+		RxBandwidthMeasurement: float64(current.rxBytes-prev.rxBytes) * bytesToBitsRatio / bitsPerMegabit / elapsed,
+		TxBandwidthMeasurement: float64(current.txBytes-prev.txBytes) * bytesToBitsRatio / bitsPerMegabit / elapsed,
+		DropsMeasurement:       float64(delta(prev.rxDrops, current.rxDrops) + delta(prev.txDrops, current.txDrops)),
+		ErrorsMeasurement:      float64(delta(prev.rxErrors, current.rxErrors) + delta(prev.txErrors, current.txErrors)),
+	}
+	if speed := n.linkSpeed(); speed > 0 {
+		values[UtilizationMeasurement] = max(values[RxBandwidthMeasurement], values[TxBandwidthMeasurement]) / speed * 100
+	}
+
+	n.writeMetric(values)
+}
+
+// linkSpeed returns the link speed in Mbit/s, or 0 when the interface does not report it
+func (n *NetworkMetric) linkSpeed() float64 {
+	content, err := os.ReadFile(n.speedPath)
+	if err != nil {
+		return 0
+	}
+	speed, err := strconv.ParseFloat(strings.TrimSpace(string(content)), 64)
+	if err != nil || speed <= 0 {
+		return 0
+	}
+	n.linkSpeedMbs = speed
+	return speed
+}
+
+func (n *NetworkMetric) writeMetric(values map[string]float64) {
+	n.AddDataPoint(values)
+
+	networkBandwidthMetric.With(prometheus.Labels{interfaceLabel: n.iface, directionLabel: "rx"}).Set(values[RxBandwidthMeasurement])
+	networkBandwidthMetric.With(prometheus.Labels{interfaceLabel: n.iface, directionLabel: "tx"}).Set(values[TxBandwidthMeasurement])
+	networkPacketIssuesMetric.With(prometheus.Labels{interfaceLabel: n.iface, issueTypeLabel: "drops"}).Add(values[DropsMeasurement])
+	networkPacketIssuesMetric.With(prometheus.Labels{interfaceLabel: n.iface, issueTypeLabel: "errors"}).Add(values[ErrorsMeasurement])
+
+	logger.WriteMetric(metric.InfrastructureGroup, n.Name, map[string]any{
+		RxBandwidthMeasurement: values[RxBandwidthMeasurement],
+		TxBandwidthMeasurement: values[TxBandwidthMeasurement],
+		UtilizationMeasurement: values[UtilizationMeasurement],
+		DropsMeasurement:       values[DropsMeasurement],
+		ErrorsMeasurement:      values[ErrorsMeasurement],
+	}, map[string]any{
+		"interface": n.iface,
+	})
+}
+
+func (n *NetworkMetric) AggregateResults() string {
+	if len(n.DataPoints) == 0 {
+		return ""
+	}
+
+	var (
+		rx, tx      []float64
+		drops, errs float64
+	)
+	for _, point := range n.DataPoints {
+		rx = append(rx, point.Values[RxBandwidthMeasurement])
+		tx = append(tx, point.Values[TxBandwidthMeasurement])
+		drops += point.Values[DropsMeasurement]
+		errs += point.Values[ErrorsMeasurement]
+	}
+	rxPercentiles := metric.CalculatePercentiles(rx, 50, 100)
+	txPercentiles := metric.CalculatePercentiles(tx, 50, 100)
+
+	result := fmt.Sprintf("rx_P50=%.2fMbps, rx_max=%.2fMbps, tx_P50=%.2fMbps, tx_max=%.2fMbps, drops=%.0f, errors=%.0f",
+		rxPercentiles[50], rxPercentiles[100], txPercentiles[50], txPercentiles[100], drops, errs)
+	if n.linkSpeedMbs > 0 {
+		result += fmt.Sprintf(", link_speed=%.0fMbps", n.linkSpeedMbs)
+	}
+
+	return result
+}
+
+// delta returns zero for counters that went back, e.g. after the interface was reset or the PID was reused by another process
+func delta(prev, current uint64) uint64 {
+	if current < prev {
+		return 0
+	}
+	return current - prev
+}
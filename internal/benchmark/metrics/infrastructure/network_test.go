@@ -0,0 +1,96 @@
+package infrastructure
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const netDevFormat = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo: 1000 10 0 0 0 0 0 0 1000 10 0 0 0 0 0 0
+  eth0: %d 100 %d %d 0 0 0 0 %d 100 0 0 0 0 0 0
+`
+
+const snmpFormat = `Ip: Forwarding DefaultTTL InReceives
+Ip: 1 64 1000
+Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens PassiveOpens AttemptFails EstabResets CurrEstab InSegs OutSegs RetransSegs InErrs OutRsts
+Tcp: 1 200 120000 -1 10 10 0 0 5 1000 %d %d 0 0
+`
+
+func TestNetworkMetric_Measure(t *testing.T) {
+	dir := t.TempDir()
+	devPath, speedPath := filepath.Join(dir, "dev"), filepath.Join(dir, "speed")
+	require.NoError(t, os.WriteFile(speedPath, []byte("1000\n"), 0o600))
+
+	network := NewNetworkMetric("eth0", "Network (eth0)", time.Second, nil)
+	network.devPath, network.speedPath = devPath, speedPath
+
+	require.NoError(t, os.WriteFile(devPath, fmt.Appendf(nil, netDevFormat, 0, 0, 0, 0), 0o600))
+	network.measure()
+	assert.Empty(t, network.DataPoints)
+
+	require.NoError(t, os.WriteFile(devPath, fmt.Appendf(nil, netDevFormat, 125_000_000, 2, 3, 12_500_000), 0o600))
+	network.prevTime = network.prevTime.Add(-time.Second * 10)
+	network.measure()
+
+	require.Len(t, network.DataPoints, 1)
+	values := network.DataPoints[0].Values
+	assert.InDelta(t, 100, values[RxBandwidthMeasurement], 1)
+	assert.InDelta(t, 10, values[TxBandwidthMeasurement], 1)
+	assert.InDelta(t, 10, values[UtilizationMeasurement], 1)
+	assert.Equal(t, 3.0, values[DropsMeasurement])
+	assert.Equal(t, 2.0, values[ErrorsMeasurement])
+	assert.Contains(t, network.AggregateResults(), "link_speed=1000Mbps")
+
+	missing := NewNetworkMetric("eth1", "Network (eth1)", time.Second, nil)
+	missing.devPath = devPath
+	missing.measure()
+	assert.True(t, missing.prevTime.IsZero())
+}
+
+func TestTCPMetric_Measure(t *testing.T) {
+	snmpPath := filepath.Join(t.TempDir(), "snmp")
+	tcp := NewTCPMetric("TCP", time.Second, nil)
+	tcp.snmpPath = snmpPath
+
+	require.NoError(t, os.WriteFile(snmpPath, fmt.Appendf(nil, snmpFormat, 1000, 10), 0o600))
+	tcp.measure()
+	assert.Empty(t, tcp.DataPoints)
+
+	// an idle window is skipped and counted by the next measurement
+	require.NoError(t, os.WriteFile(snmpPath, fmt.Appendf(nil, snmpFormat, 1020, 11), 0o600))
+	tcp.measure()
+	assert.Empty(t, tcp.DataPoints)
+
+	require.NoError(t, os.WriteFile(snmpPath, fmt.Appendf(nil, snmpFormat, 3000, 50), 0o600))
+	tcp.measure()
+
+	require.Len(t, tcp.DataPoints, 1)
+	assert.InDelta(t, 2, tcp.DataPoints[0].Values[RetransmitRateMeasurement], 0.001)
+	assert.Contains(t, tcp.AggregateResults(), "retransmit_rate_max=2.00%")
+}
+
+func TestPhysicalInterfaces(t *testing.T) {
+	dir := t.TempDir()
+	devPath, sysPath := filepath.Join(dir, "dev"), filepath.Join(dir, "net")
+	require.NoError(t, os.WriteFile(devPath, fmt.Appendf(nil, netDevFormat+"docker0: 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0\n", 0, 0, 0, 0), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "eth0", "device"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "docker0"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(sysPath, "lo"), 0o700))
+
+	ifaces, err := physicalInterfaces(devPath, sysPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eth0"}, ifaces)
+
+	// hosts without physical interfaces fall back to all interfaces but the loopback
+	require.NoError(t, os.Remove(filepath.Join(sysPath, "eth0", "device")))
+	ifaces, err = physicalInterfaces(devPath, sysPath)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"eth0", "docker0"}, ifaces)
+}
@@ -0,0 +1,574 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	ProcessesMeasurement       = "Processes"
+	ProcessCPUMeasurement      = "CPU"
+	RSSMeasurement             = "RSS"
+	FileDescriptorsMeasurement = "FileDescriptors"
+	ThreadsMeasurement         = "Threads"
+	ReadThroughputMeasurement  = "ReadThroughput"
+	WriteThroughputMeasurement = "WriteThroughput"
+	MemoryLimitMeasurement     = "MemoryLimitUsage"
+	CPULimitMeasurement        = "CPULimitUsage"
+
+	procPath   = "/proc"
+	cgroupPath = "/sys/fs/cgroup"
+	// clockTicks is the USER_HZ the kernel reports the CPU times of '/proc/<pid>/stat' in, which is fixed on Linux
+	clockTicks     = 100
+	unlimitedValue = "max"
+)
+
+type ProcessTargetKind string
+
+const (
+	PIDTarget    ProcessTargetKind = "pid"
+	NameTarget   ProcessTargetKind = "name"
+	CGroupTarget ProcessTargetKind = "cgroup"
+)
+
+// ProcessTarget selects the processes of a node either by PID, by process name or by cgroup v2 path relative to '/sys/fs/cgroup'
+type ProcessTarget struct {
+	Label string
+	Kind  ProcessTargetKind
+	Value string
+}
+
+// ParseProcessTarget parses a target in the '[label=]kind:value' format, e.g. 'ssv=cgroup:/system.slice/ssv.service',
+// 'name:geth' or 'pid:1234'. The label defaults to the value.
+func ParseProcessTarget(target string) (ProcessTarget, error) {
+	label, selector, found := strings.Cut(target, "=")
+	if !found {
+		label, selector = "", target
+	}
+	kind, value, found := strings.Cut(selector, ":")
+	if !found || value == "" {
+		return ProcessTarget{}, fmt.Errorf("process target: '%s' was not in the '[label=]kind:value' format", target)
+	}
+	if label == "" {
+		label = value
+	}
+
+	switch ProcessTargetKind(kind) {
+	case PIDTarget:
+		if pid, err := strconv.Atoi(value); err != nil || pid <= 0 {
+			return ProcessTarget{}, fmt.Errorf("process target: '%s' had an invalid PID", target)
+		}
+	case NameTarget, CGroupTarget:
+	default:
+		return ProcessTarget{}, fmt.Errorf("process target: '%s' had an unknown kind, expected one of 'pid', 'name' or 'cgroup'", target)
+	}
+
+	return ProcessTarget{Label: label, Kind: ProcessTargetKind(kind), Value: value}, nil
+}
+
+type processCounters struct {
+	cpuTicks, readBytes, writeBytes uint64
+}
+
+type processStat struct {
+	cpuTicks, threads, rssPages uint64
+}
+
+type cgroupLimits struct {
+	// memoryUsage is the working set, the memory charged to the cgroup without the inactive file-backed pages
+	memoryUsage, memoryMax uint64
+	// cpuCores is the CPU quota divided by the period, e.g. 1.5 for a quota of 150ms per 100ms
+	cpuCores float64
+}
+
+// ProcessMetric reports the resources used by the processes of the target. The CPU usage is in percent of a single core,
+// so a process using two cores reports 200%. When the processes run in a cgroup with a memory or CPU limit, the usage
+// is also reported in percent of the limit. The memory usage is then the working set of the cgroup, which leaves out the
+// inactive page cache the kernel reclaims before reaching the limit, the same way cAdvisor and the kubelet calculate it.
+// Reading the file descriptors and the I/O of processes owned by other users requires elevated permissions.
+type ProcessMetric struct {
+	metric.Base[float64]
+	target     ProcessTarget
+	procPath   string
+	cgroupPath string
+	interval   time.Duration
+	prev       map[int]processCounters
+	prevCGroup uint64
+	prevTime   time.Time
+}
+
+func NewProcessMetric(target ProcessTarget, name string, interval time.Duration, healthCondition []metric.HealthCondition[float64]) *ProcessMetric {
+	return &ProcessMetric{
+		target:     target,
+		procPath:   procPath,
+		cgroupPath: cgroupPath,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (p *ProcessMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", p.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			p.measure()
+		}
+	}
+}
+
+func (p *ProcessMetric) measure() {
+	pids, err := p.pids()
+	if err != nil {
+		logger.WriteError(metric.InfrastructureGroup, p.Name, errors.Join(err, errors.New("failed listing processes of the target")))
+		return
+	}
+	now := time.Now()
+
+	var (
+		threads, rssPages, fds  uint64
+		cpuTicks, read, written uint64
+		counters                = make(map[int]processCounters)
+		firstPID                int
+		readableFDs, readableIO bool
+	)
+	for _, pid := range pids {
+		stat, err := readProcessStat(p.procPath, pid)
+		if err != nil {
+			// the process exited after it was listed
+			continue
+		}
+		if len(counters) == 0 {
+			firstPID = pid
+		}
+		current := processCounters{cpuTicks: stat.cpuTicks}
+		threads += stat.threads
+		rssPages += stat.rssPages
+
+		if entries, err := os.ReadDir(filepath.Join(p.procPath, strconv.Itoa(pid), "fd")); err == nil {
+			fds += uint64(len(entries))
+			readableFDs = true
+		}
+		if current.readBytes, current.writeBytes, err = readProcessIO(p.procPath, pid); err == nil {
+			readableIO = true
+		}
+		counters[pid] = current
+
+		// processes started since the previous measurement are only counted from the next one
+		if prev, ok := p.prev[pid]; ok {
+			cpuTicks += delta(prev.cpuTicks, current.cpuTicks)
+			read += delta(prev.readBytes, current.readBytes)
+			written += delta(prev.writeBytes, current.writeBytes)
+		}
+	}
+
+	if len(counters) == 0 {
+		p.prev, p.prevTime, p.prevCGroup = nil, time.Time{}, 0
+		p.writeMetric(map[string]float64{ProcessesMeasurement: 0})
+		return
+	}
+
+	values := map[string]float64{
+		ProcessesMeasurement: float64(len(counters)),
+		RSSMeasurement:       toMegabytes(rssPages * uint64(os.Getpagesize())),
+		ThreadsMeasurement:   float64(threads),
+	}
+	if readableFDs {
+		values[FileDescriptorsMeasurement] = float64(fds)
+	}
+
+	cgroup := p.target.Value
+	if p.target.Kind != CGroupTarget {
+		cgroup, err = processCGroup(p.procPath, firstPID)
+		if err != nil {
+			cgroup = ""
+		}
+	}
+
+	// the cgroup keeps the CPU time of the processes exited since the previous measurement
+	var cgroupUsage uint64
+	if p.target.Kind == CGroupTarget {
+		if cgroupUsage, err = readCGroupCPUUsage(p.cgroupPath, cgroup); err != nil {
+			cgroupUsage = 0
+		}
+	}
+
+	prevTime, prevCGroup := p.prevTime, p.prevCGroup
+	p.prev, p.prevTime, p.prevCGroup = counters, now, cgroupUsage
+
+	if !prevTime.IsZero() {
+		elapsed := now.Sub(prevTime).Seconds()
+		values[ProcessCPUMeasurement] = float64(cpuTicks) / clockTicks / elapsed * 100
+		if cgroupUsage != 0 && prevCGroup != 0 {
+			values[ProcessCPUMeasurement] = float64(delta(prevCGroup, cgroupUsage)) / float64(time.Second/time.Microsecond) / elapsed * 100
+		}
+		if readableIO {
+			values[ReadThroughputMeasurement] = toMegabytes(read) / elapsed
+			values[WriteThroughputMeasurement] = toMegabytes(written) / elapsed
+		}
+	}
+
+	if cgroup != "" {
+		if limits, err := readCGroupLimits(p.cgroupPath, cgroup); err == nil {
+			if limits.memoryMax != 0 {
+				values[MemoryLimitMeasurement] = float64(limits.memoryUsage) / float64(limits.memoryMax) * 100
+			}
+			if cpu, ok := values[ProcessCPUMeasurement]; ok && limits.cpuCores != 0 {
+				values[CPULimitMeasurement] = cpu / limits.cpuCores
+			}
+		}
+	}
+
+	p.writeMetric(values)
+}
+
+// pids lists the processes of the target, an empty list is returned when none of them run
+func (p *ProcessMetric) pids() ([]int, error) {
+	switch p.target.Kind {
+	case PIDTarget:
+		pid, err := strconv.Atoi(p.target.Value)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(filepath.Join(p.procPath, p.target.Value)); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []int{pid}, nil
+	case NameTarget:
+		return p.pidsByName()
+	case CGroupTarget:
+		return p.pidsByCGroup()
+	default:
+		return nil, fmt.Errorf("process target kind: '%s' was not supported", p.target.Kind)
+	}
+}
+
+// pidsByName matches the name with the command of the process, which the kernel truncates to 15 characters, and with the
+// base name of the executable the process was started with
+func (p *ProcessMetric) pidsByName() ([]int, error) {
+	entries, err := os.ReadDir(p.procPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var pids []int
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		comm, err := os.ReadFile(filepath.Join(p.procPath, entry.Name(), "comm"))
+		if err != nil {
+			continue
+		}
+		if strings.TrimSpace(string(comm)) == p.target.Value {
+			pids = append(pids, pid)
+			continue
+		}
+		cmdline, err := os.ReadFile(filepath.Join(p.procPath, entry.Name(), "cmdline"))
+		if err != nil {
+			continue
+		}
+		if executable, _, _ := strings.Cut(string(cmdline), "\x00"); executable != "" && filepath.Base(executable) == p.target.Value {
+			pids = append(pids, pid)
+		}
+	}
+
+	return pids, nil
+}
+
+// pidsByCGroup lists the processes of the cgroup and of its descendants, e.g. the processes of a container
+func (p *ProcessMetric) pidsByCGroup() ([]int, error) {
+	root := filepath.Join(p.cgroupPath, p.target.Value)
+	var pids []int
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		// the cgroup is removed when e.g. the container is stopped
+		if path == root && errors.Is(err, fs.ErrNotExist) {
+			return fs.SkipAll
+		}
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		content, err := os.ReadFile(filepath.Join(path, "cgroup.procs"))
+		if err != nil {
+			return err
+		}
+		for _, line := range strings.Fields(string(content)) {
+			pid, err := strconv.Atoi(line)
+			if err != nil {
+				return errors.Join(err, fmt.Errorf("failed parsing PID of cgroup: '%s'", path))
+			}
+			pids = append(pids, pid)
+		}
+		return nil
+	})
+
+	return pids, err
+}
+
+func (p *ProcessMetric) writeMetric(values map[string]float64) {
+	p.AddDataPoint(values)
+
+	for name, gauge := range map[string]*prometheus.GaugeVec{
+		ProcessesMeasurement:       processCountMetric,
+		ProcessCPUMeasurement:      processCPUMetric,
+		RSSMeasurement:             processRSSMetric,
+		FileDescriptorsMeasurement: processFileDescriptorsMetric,
+		ThreadsMeasurement:         processThreadsMetric,
+	} {
+		if value, ok := values[name]; ok {
+			gauge.With(prometheus.Labels{targetLabel: p.target.Label}).Set(value)
+		}
+	}
+	for name, labels := range map[string]prometheus.Labels{
+		ReadThroughputMeasurement:  {targetLabel: p.target.Label, directionLabel: "read"},
+		WriteThroughputMeasurement: {targetLabel: p.target.Label, directionLabel: "write"},
+	} {
+		if value, ok := values[name]; ok {
+			processIOMetric.With(labels).Set(value)
+		}
+	}
+	for name, labels := range map[string]prometheus.Labels{
+		MemoryLimitMeasurement: {targetLabel: p.target.Label, limitResourceLabel: "memory"},
+		CPULimitMeasurement:    {targetLabel: p.target.Label, limitResourceLabel: "cpu"},
+	} {
+		if value, ok := values[name]; ok {
+			processLimitUsageMetric.With(labels).Set(value)
+		}
+	}
+
+	logger.WriteMetric(metric.InfrastructureGroup, p.Name, map[string]any{
+		ProcessesMeasurement:       values[ProcessesMeasurement],
+		ProcessCPUMeasurement:      values[ProcessCPUMeasurement],
+		RSSMeasurement:             values[RSSMeasurement],
+		FileDescriptorsMeasurement: values[FileDescriptorsMeasurement],
+		ThreadsMeasurement:         values[ThreadsMeasurement],
+		ReadThroughputMeasurement:  values[ReadThroughputMeasurement],
+		WriteThroughputMeasurement: values[WriteThroughputMeasurement],
+		MemoryLimitMeasurement:     values[MemoryLimitMeasurement],
+		CPULimitMeasurement:        values[CPULimitMeasurement],
+	}, map[string]any{
+		"target": fmt.Sprintf("%s:%s", p.target.Kind, p.target.Value),
+	})
+}
+
+func (p *ProcessMetric) AggregateResults() string {
+	if len(p.DataPoints) == 0 {
+		return ""
+	}
+
+	values := make(map[string][]float64)
+	var stopped int
+	for _, point := range p.DataPoints {
+		if point.Values[ProcessesMeasurement] == 0 {
+			stopped++
+		}
+		for name, value := range point.Values {
+			values[name] = append(values[name], value)
+		}
+	}
+
+	cpu := metric.CalculatePercentiles(values[ProcessCPUMeasurement], 50, 100)
+	result := fmt.Sprintf("cpu_P50=%.2f%%, cpu_max=%.2f%%, rss_max=%.2fMB, threads_max=%.0f",
+		cpu[50], cpu[100],
+		metric.CalculatePercentiles(values[RSSMeasurement], 100)[100],
+		metric.CalculatePercentiles(values[ThreadsMeasurement], 100)[100])
+	if fds := values[FileDescriptorsMeasurement]; len(fds) != 0 {
+		result += fmt.Sprintf(", fds_max=%.0f", metric.CalculatePercentiles(fds, 100)[100])
+	}
+	if read := values[ReadThroughputMeasurement]; len(read) != 0 {
+		result += fmt.Sprintf(", read_P50=%.2fMB/s, write_P50=%.2fMB/s",
+			metric.CalculatePercentiles(read, 50)[50],
+			metric.CalculatePercentiles(values[WriteThroughputMeasurement], 50)[50])
+	}
+	if memory := values[MemoryLimitMeasurement]; len(memory) != 0 {
+		result += fmt.Sprintf(", memory_limit_usage_max=%.2f%%", metric.CalculatePercentiles(memory, 100)[100])
+	}
+	if cpuLimit := values[CPULimitMeasurement]; len(cpuLimit) != 0 {
+		result += fmt.Sprintf(", cpu_limit_usage_P50=%.2f%%", metric.CalculatePercentiles(cpuLimit, 50)[50])
+	}
+	if stopped != 0 {
+		result += fmt.Sprintf(", not_running=%d", stopped)
+	}
+
+	return result
+}
+
+// readProcessStat parses '/proc/<pid>/stat', the command in the second field is skipped as it can contain spaces and parentheses
+func readProcessStat(procPath string, pid int) (processStat, error) {
+	content, err := os.ReadFile(filepath.Join(procPath, strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return processStat{}, err
+	}
+	i := strings.LastIndexByte(string(content), ')')
+	if i < 0 {
+		return processStat{}, fmt.Errorf("stat of process: '%d' was not valid", pid)
+	}
+	// the fields start with the state, which is the third field of the stat
+	fields := strings.Fields(string(content[i+1:]))
+	if len(fields) < 22 {
+		return processStat{}, fmt.Errorf("stat of process: '%d' had %d fields, expected at least 24", pid, len(fields)+2)
+	}
+
+	var values [4]uint64
+	for n, field := range []int{11, 12, 17, 21} { // utime, stime, num_threads, rss
+		if values[n], err = strconv.ParseUint(fields[field], 10, 64); err != nil {
+			return processStat{}, errors.Join(err, fmt.Errorf("failed parsing stat of process: '%d'", pid))
+		}
+	}
+
+	return processStat{
+		cpuTicks: values[0] + values[1],
+		threads:  values[2],
+		rssPages: values[3],
+	}, nil
+}
+
+// readProcessIO returns the bytes the process caused to be read from and written to the storage
+func readProcessIO(procPath string, pid int) (read, written uint64, err error) {
+	file, err := os.Open(filepath.Join(procPath, strconv.Itoa(pid), "io"))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		switch name {
+		case "read_bytes":
+			read, err = strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		case "write_bytes":
+			written, err = strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		}
+		if err != nil {
+			return 0, 0, errors.Join(err, fmt.Errorf("failed parsing I/O of process: '%d'", pid))
+		}
+	}
+
+	return read, written, scanner.Err()
+}
+
+// processCGroup returns the cgroup v2 path of the process, relative to '/sys/fs/cgroup'
+func processCGroup(procPath string, pid int) (string, error) {
+	content, err := os.ReadFile(filepath.Join(procPath, strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if path, found := strings.CutPrefix(line, "0::"); found {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("process: '%d' was not in a cgroup v2 hierarchy", pid)
+}
+
+// readCGroupCPUUsage returns the CPU time used by the cgroup in microseconds
+func readCGroupCPUUsage(cgroupPath, cgroup string) (uint64, error) {
+	content, err := os.ReadFile(filepath.Join(cgroupPath, cgroup, "cpu.stat"))
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if value, found := strings.CutPrefix(line, "usage_usec "); found {
+			return strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("CPU usage of cgroup: '%s' was not found", cgroup)
+}
+
+// readCGroupInactiveFile returns the inactive file-backed memory of the cgroup, i.e. the page cache reclaimed first
+func readCGroupInactiveFile(cgroupPath, cgroup string) (uint64, error) {
+	content, err := os.ReadFile(filepath.Join(cgroupPath, cgroup, "memory.stat"))
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if value, found := strings.CutPrefix(line, "inactive_file "); found {
+			inactiveFile, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+			if err != nil {
+				return 0, errors.Join(err, fmt.Errorf("failed parsing inactive file memory of cgroup: '%s'", cgroup))
+			}
+			return inactiveFile, nil
+		}
+	}
+
+	return 0, fmt.Errorf("inactive file memory of cgroup: '%s' was not found", cgroup)
+}
+
+// readCGroupLimits reads the memory and CPU limits of the cgroup, limits not set are left at zero
+func readCGroupLimits(cgroupPath, cgroup string) (cgroupLimits, error) {
+	var limits cgroupLimits
+
+	memoryMax, err := os.ReadFile(filepath.Join(cgroupPath, cgroup, "memory.max"))
+	if err == nil && strings.TrimSpace(string(memoryMax)) != unlimitedValue {
+		if limits.memoryMax, err = strconv.ParseUint(strings.TrimSpace(string(memoryMax)), 10, 64); err != nil {
+			return cgroupLimits{}, errors.Join(err, fmt.Errorf("failed parsing memory limit of cgroup: '%s'", cgroup))
+		}
+		current, err := os.ReadFile(filepath.Join(cgroupPath, cgroup, "memory.current"))
+		if err != nil {
+			return cgroupLimits{}, err
+		}
+		usage, err := strconv.ParseUint(strings.TrimSpace(string(current)), 10, 64)
+		if err != nil {
+			return cgroupLimits{}, errors.Join(err, fmt.Errorf("failed parsing memory usage of cgroup: '%s'", cgroup))
+		}
+		inactiveFile, err := readCGroupInactiveFile(cgroupPath, cgroup)
+		if err != nil {
+			return cgroupLimits{}, err
+		}
+		if usage > inactiveFile {
+			limits.memoryUsage = usage - inactiveFile
+		}
+	}
+
+	// 'cpu.max' holds the quota and the period in microseconds, the quota is 'max' when the CPU is not limited
+	cpuMax, err := os.ReadFile(filepath.Join(cgroupPath, cgroup, "cpu.max"))
+	if err == nil {
+		fields := strings.Fields(string(cpuMax))
+		if len(fields) == 2 && fields[0] != unlimitedValue {
+			quota, quotaErr := strconv.ParseFloat(fields[0], 64)
+			period, periodErr := strconv.ParseFloat(fields[1], 64)
+			if err := errors.Join(quotaErr, periodErr); err != nil {
+				return cgroupLimits{}, errors.Join(err, fmt.Errorf("failed parsing CPU limit of cgroup: '%s'", cgroup))
+			}
+			if period != 0 {
+				limits.cpuCores = quota / period
+			}
+		}
+	}
+
+	return limits, nil
+}
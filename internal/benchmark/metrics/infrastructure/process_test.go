@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProcessTarget(t *testing.T) {
+	tests := []struct {
+		target   string
+		expected ProcessTarget
+		wantErr  bool
+	}{
+		{target: "pid:1234", expected: ProcessTarget{Label: "1234", Kind: PIDTarget, Value: "1234"}},
+		{target: "geth=name:geth", expected: ProcessTarget{Label: "geth", Kind: NameTarget, Value: "geth"}},
+		{target: "ssv=cgroup:/system.slice/ssv.service", expected: ProcessTarget{Label: "ssv", Kind: CGroupTarget, Value: "/system.slice/ssv.service"}},
+		{target: "pid:-1", wantErr: true},
+		{target: "path:/usr/bin/geth", wantErr: true},
+		{target: "geth", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.target, func(t *testing.T) {
+			target, err := ParseProcessTarget(test.target)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, target)
+		})
+	}
+}
+
+func TestProcessMetric_Measure(t *testing.T) {
+	root := t.TempDir()
+	cgroup := filepath.Join(root, "node")
+	require.NoError(t, os.Mkdir(cgroup, 0o700))
+	for name, content := range map[string]string{
+		"cgroup.procs":   strconv.Itoa(os.Getpid()),
+		"cpu.stat":       "usage_usec 1000000\nuser_usec 800000\n",
+		"cpu.max":        "50000 100000",
+		"memory.max":     "1000000000",
+		"memory.current": "700000000",
+		"memory.stat":    "anon 400000000\nfile 300000000\nactive_file 100000000\ninactive_file 200000000\n",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(cgroup, name), []byte(content), 0o600))
+	}
+
+	process := NewProcessMetric(ProcessTarget{Label: "node", Kind: CGroupTarget, Value: "/node"}, "Process (node)", time.Second, nil)
+	process.cgroupPath = root
+
+	process.measure()
+	require.Len(t, process.DataPoints, 1)
+	values := process.DataPoints[0].Values
+	assert.Equal(t, 1.0, values[ProcessesMeasurement])
+	assert.Greater(t, values[RSSMeasurement], 0.0)
+	assert.GreaterOrEqual(t, values[ThreadsMeasurement], 1.0)
+	assert.Equal(t, 50.0, values[MemoryLimitMeasurement])
+	assert.NotContains(t, values, ProcessCPUMeasurement)
+
+	require.NoError(t, os.WriteFile(filepath.Join(cgroup, "cpu.stat"), []byte("usage_usec 2000000\n"), 0o600))
+	process.prevTime = process.prevTime.Add(-time.Second * 10)
+	process.measure()
+
+	require.Len(t, process.DataPoints, 2)
+	values = process.DataPoints[1].Values
+	assert.InDelta(t, 10, values[ProcessCPUMeasurement], 0.5)
+	assert.InDelta(t, 20, values[CPULimitMeasurement], 1)
+	assert.Contains(t, process.AggregateResults(), "memory_limit_usage_max=50.00%")
+
+	stopped := NewProcessMetric(ProcessTarget{Label: "stopped", Kind: CGroupTarget, Value: "/stopped"}, "Process (stopped)", time.Second, nil)
+	stopped.cgroupPath = root
+	stopped.measure()
+	require.Len(t, stopped.DataPoints, 1)
+	assert.Equal(t, 0.0, stopped.DataPoints[0].Values[ProcessesMeasurement])
+	assert.Contains(t, stopped.AggregateResults(), "not_running=1")
+}
@@ -10,6 +10,13 @@ const (
 	subsystem            = "infrastructure"
 	memoryUsageTypeLabel = "type"
 	cpuUsageTypeLabel    = "type"
+	pathLabel            = "path"
+	diskResourceLabel    = "resource"
+	interfaceLabel       = "interface"
+	directionLabel       = "direction"
+	issueTypeLabel       = "type"
+	targetLabel          = "target"
+	limitResourceLabel   = "resource"
 )
 
 var (
@@ -28,4 +35,101 @@ var (
 			Namespace: namespace,
 			Subsystem: subsystem,
 		}, []string{cpuUsageTypeLabel})
+
+	diskFreeMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "disk_free_percent",
+			Help:      "free disk space or inodes of the filesystem containing the path",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{pathLabel, diskResourceLabel})
+
+	fsyncDurationMetric = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:      "disk_fsync_duration_seconds",
+			Help:      "duration of the fsync of a block written to a scratch file in the path",
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 12),
+		}, []string{pathLabel})
+
+	networkBandwidthMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "network_bandwidth_mbps",
+			Help:      "receive and transmit bandwidth of the interface in Mbit/s",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{interfaceLabel, directionLabel})
+
+	networkPacketIssuesMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "network_packet_issues",
+			Help:      "number of packets dropped and errors of the interface",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{interfaceLabel, issueTypeLabel})
+
+	tcpRetransmitRateMetric = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name:      "tcp_retransmit_rate",
+			Help:      "percentage of TCP segments retransmitted by the host",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		})
+
+	processCountMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "process_count",
+			Help:      "number of processes of the target",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{targetLabel})
+
+	processCPUMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "process_cpu_percent",
+			Help:      "CPU usage of the processes of the target in percent of a single core",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{targetLabel})
+
+	processRSSMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "process_resident_memory_mb",
+			Help:      "resident memory of the processes of the target in MB",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{targetLabel})
+
+	processFileDescriptorsMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "process_open_fds",
+			Help:      "open file descriptors of the processes of the target",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{targetLabel})
+
+	processThreadsMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "process_threads",
+			Help:      "threads of the processes of the target",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{targetLabel})
+
+	processIOMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "process_io_mb_per_second",
+			Help:      "storage read and write throughput of the processes of the target in MB/s",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{targetLabel, directionLabel})
+
+	processLimitUsageMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "process_limit_usage_percent",
+			Help:      "memory and CPU usage of the processes of the target in percent of the cgroup limit",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{targetLabel, limitResourceLabel})
 )
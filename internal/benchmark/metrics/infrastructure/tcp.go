@@ -0,0 +1,154 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	RetransmitRateMeasurement = "RetransmitRate"
+
+	procNetSNMPPath = "/proc/net/snmp"
+	// minSentSegments is the number of segments a measurement requires, a few retransmissions of an idle host are not reported
+	minSentSegments = 1000
+)
+
+type tcpCounters struct {
+	outSegs, retransSegs uint64
+}
+
+// readTCPCounters parses the TCP segment counters of '/proc/net/snmp', which lists a header line followed by a value line per protocol
+func readTCPCounters(path string) (tcpCounters, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return tcpCounters{}, err
+	}
+	defer file.Close()
+
+	var header []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || fields[0] != "Tcp:" {
+			continue
+		}
+		if header == nil {
+			header = fields
+			continue
+		}
+
+		var counters tcpCounters
+		for name, counter := range map[string]*uint64{"OutSegs": &counters.outSegs, "RetransSegs": &counters.retransSegs} {
+			i := slices.Index(header, name)
+			if i < 0 || i >= len(fields) {
+				return tcpCounters{}, fmt.Errorf("TCP counter: '%s' was not found", name)
+			}
+			if *counter, err = strconv.ParseUint(fields[i], 10, 64); err != nil {
+				return tcpCounters{}, errors.Join(err, fmt.Errorf("failed parsing TCP counter: '%s'", name))
+			}
+		}
+		return counters, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return tcpCounters{}, err
+	}
+
+	return tcpCounters{}, errors.New("TCP counters were not found")
+}
+
+// TCPMetric reports the percentage of TCP segments retransmitted since the previous measurement. The kernel only keeps
+// host-wide TCP counters, so the rate covers all interfaces. Measurements with fewer sent segments than the minimum are
+// skipped and their segments are counted by the next measurement.
+type TCPMetric struct {
+	metric.Base[float64]
+	snmpPath string
+	interval time.Duration
+	prev     tcpCounters
+	measured bool
+}
+
+func NewTCPMetric(name string, interval time.Duration, healthCondition []metric.HealthCondition[float64]) *TCPMetric {
+	return &TCPMetric{
+		snmpPath: procNetSNMPPath,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (t *TCPMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", t.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			t.measure()
+		}
+	}
+}
+
+func (t *TCPMetric) measure() {
+	current, err := readTCPCounters(t.snmpPath)
+	if err != nil {
+		logger.WriteError(metric.InfrastructureGroup, t.Name, err)
+		return
+	}
+
+	prev, measured := t.prev, t.measured
+	if measured && current.outSegs >= prev.outSegs && current.outSegs-prev.outSegs < minSentSegments {
+		return
+	}
+	t.prev, t.measured = current, true
+	if !measured || current.outSegs <= prev.outSegs || current.retransSegs < prev.retransSegs {
+		return
+	}
+
+	t.writeMetric(current.retransSegs-prev.retransSegs, current.outSegs-prev.outSegs)
+}
+
+func (t *TCPMetric) writeMetric(retransmitted, sent uint64) {
+	rate := float64(retransmitted) / float64(sent) * 100
+	t.AddDataPoint(map[string]float64{
+		RetransmitRateMeasurement: rate,
+	})
+
+	tcpRetransmitRateMetric.Set(rate)
+
+	logger.WriteMetric(metric.InfrastructureGroup, t.Name, map[string]any{
+		RetransmitRateMeasurement: rate,
+	}, map[string]any{
+		"retransmitted_segments": retransmitted,
+		"sent_segments":          sent,
+	})
+}
+
+func (t *TCPMetric) AggregateResults() string {
+	if len(t.DataPoints) == 0 {
+		return ""
+	}
+
+	var rates []float64
+	for _, point := range t.DataPoints {
+		rates = append(rates, point.Values[RetransmitRateMeasurement])
+	}
+	percentiles := metric.CalculatePercentiles(rates, 50, 90, 100)
+
+	return fmt.Sprintf("retransmit_rate_P50=%.2f%%, retransmit_rate_P90=%.2f%%, retransmit_rate_max=%.2f%%", percentiles[50], percentiles[90], percentiles[100])
+}
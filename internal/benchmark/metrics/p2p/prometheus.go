@@ -0,0 +1,52 @@
+package p2p
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	namespace         = "pulse"
+	subsystem         = "p2p"
+	hostLabelName     = "host"
+	portLabelName     = "port"
+	protocolLabelName = "protocol"
+	statusLabelName   = "status"
+)
+
+var (
+	portStatusMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "port_status",
+			Help:      "set to 1 for the status reported by the latest probe of the port",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{hostLabelName, portLabelName, protocolLabelName, statusLabelName})
+
+	dialDurationMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "dial_duration_seconds",
+			Help:      "duration of the latest probe of the port",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{hostLabelName, portLabelName, protocolLabelName})
+)
+
+func portLabels(host string, port uint16, protocol string) map[string]string {
+	return map[string]string{
+		hostLabelName:     host,
+		portLabelName:     strconv.Itoa(int(port)),
+		protocolLabelName: protocol,
+	}
+}
+
+func portStatusLabels(host string, port uint16, protocol, status string) map[string]string {
+	return map[string]string{
+		hostLabelName:     host,
+		portLabelName:     strconv.Itoa(int(port)),
+		protocolLabelName: protocol,
+		statusLabelName:   status,
+	}
+}
@@ -0,0 +1,244 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	TCPMeasurement = "TCP"
+	UDPMeasurement = "UDP"
+
+	// StatusOpen is reported when the TCP handshake completes or the UDP probe gets a response
+	StatusOpen = "open"
+	// StatusClosed is reported when the host refuses the connection, i.e. nothing listens on the port
+	StatusClosed = "closed"
+	// StatusFiltered is reported when the probe times out. Nodes usually do not respond to the UDP probe,
+	// so a filtered UDP port may be open as well.
+	StatusFiltered = "filtered"
+
+	dialTimeout = 3 * time.Second
+)
+
+var statuses = []string{StatusOpen, StatusClosed, StatusFiltered}
+
+// udpProbe is not a valid discovery packet, it only provokes an ICMP port unreachable response when the port is closed
+var udpProbe = []byte("ssv-pulse")
+
+type probeResult struct {
+	status   string
+	duration time.Duration
+}
+
+// ReachabilityMetric checks from the host running the benchmark whether the P2P ports of a node are reachable. A closed
+// or filtered TCP port is usually a firewall or NAT issue and explains a node without inbound connections.
+type ReachabilityMetric struct {
+	metric.Base[string]
+	group     metric.Group
+	host      string
+	tcpPort   uint16
+	udpPort   uint16
+	interval  time.Duration
+	durations map[string][]time.Duration
+}
+
+// NewReachabilityMetric creates a metric probing the TCP and UDP ports of the host. A port set to 0 is not probed.
+func NewReachabilityMetric(group metric.Group, host string, tcpPort, udpPort uint16, name string, interval time.Duration, healthCondition []metric.HealthCondition[string]) *ReachabilityMetric {
+	return &ReachabilityMetric{
+		group:   group,
+		host:    host,
+		tcpPort: tcpPort,
+		udpPort: udpPort,
+		Base: metric.Base[string]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval:  interval,
+		durations: make(map[string][]time.Duration),
+	}
+}
+
+func (r *ReachabilityMetric) Measure(ctx context.Context) {
+	r.measure(ctx)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", r.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			r.measure(ctx)
+		}
+	}
+}
+
+func (r *ReachabilityMetric) measure(ctx context.Context) {
+	results := make(map[string]probeResult)
+	if r.tcpPort != 0 {
+		result, err := probeTCP(ctx, r.address(r.tcpPort))
+		if err != nil {
+			logger.WriteError(r.group, r.Name, errors.Join(err, errors.New("failed probing TCP port")))
+			return
+		}
+		results[TCPMeasurement] = result
+	}
+	if r.udpPort != 0 {
+		result, err := probeUDP(ctx, r.address(r.udpPort))
+		if err != nil {
+			logger.WriteError(r.group, r.Name, errors.Join(err, errors.New("failed probing UDP port")))
+			return
+		}
+		results[UDPMeasurement] = result
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	r.writeMetric(results)
+}
+
+func (r *ReachabilityMetric) address(port uint16) string {
+	return net.JoinHostPort(r.host, strconv.Itoa(int(port)))
+}
+
+// probeTCP dials the address. Errors other than a refused connection or a timeout, e.g. a failed DNS lookup, are returned.
+func probeTCP(ctx context.Context, address string) (probeResult, error) {
+	dialer := net.Dialer{Timeout: dialTimeout}
+
+	start := time.Now()
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	duration := time.Since(start)
+	if err == nil {
+		conn.Close()
+		return probeResult{status: StatusOpen, duration: duration}, nil
+	}
+
+	return failedProbe(err, duration)
+}
+
+// probeUDP sends a datagram to the address and waits for any response. The ICMP port unreachable response to a datagram
+// sent to a closed port is surfaced as a refused connection by the read on the connected socket.
+func probeUDP(ctx context.Context, address string) (probeResult, error) {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "udp", address)
+	if err != nil {
+		return probeResult{}, err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(dialTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		return probeResult{}, err
+	}
+
+	start := time.Now()
+	if _, err := conn.Write(udpProbe); err != nil {
+		return failedProbe(err, time.Since(start))
+	}
+	buf := make([]byte, 1)
+	_, err = conn.Read(buf)
+	duration := time.Since(start)
+	if err == nil {
+		return probeResult{status: StatusOpen, duration: duration}, nil
+	}
+
+	return failedProbe(err, duration)
+}
+
+func failedProbe(err error, duration time.Duration) (probeResult, error) {
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return probeResult{status: StatusClosed, duration: duration}, nil
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return probeResult{status: StatusFiltered, duration: duration}, nil
+	}
+	if errors.Is(err, syscall.EHOSTUNREACH) || errors.Is(err, syscall.ENETUNREACH) {
+		return probeResult{status: StatusFiltered, duration: duration}, nil
+	}
+	return probeResult{}, err
+}
+
+func (r *ReachabilityMetric) writeMetric(results map[string]probeResult) {
+	values := make(map[string]string)
+	fields := make(map[string]any)
+	for protocol, result := range results {
+		values[protocol] = result.status
+		r.durations[protocol] = append(r.durations[protocol], result.duration)
+
+		port := r.tcpPort
+		if protocol == UDPMeasurement {
+			port = r.udpPort
+		}
+		for _, status := range statuses {
+			var value float64
+			if status == result.status {
+				value = 1
+			}
+			portStatusMetric.With(portStatusLabels(r.host, port, protocol, status)).Set(value)
+		}
+		dialDurationMetric.With(portLabels(r.host, port, protocol)).Set(result.duration.Seconds())
+
+		fields[protocol] = result.status
+		fields[fmt.Sprintf("%s_duration", strings.ToLower(protocol))] = result.duration.Milliseconds()
+	}
+	r.AddDataPoint(values)
+
+	logger.WriteMetric(r.group, r.Name, fields, map[string]any{
+		"host": r.host,
+	})
+}
+
+func (r *ReachabilityMetric) AggregateResults() string {
+	if len(r.DataPoints) == 0 {
+		return ""
+	}
+
+	var results []string
+	for _, protocol := range []string{TCPMeasurement, UDPMeasurement} {
+		port := r.tcpPort
+		if protocol == UDPMeasurement {
+			port = r.udpPort
+		}
+		if port == 0 {
+			continue
+		}
+
+		counts := make(map[string]int)
+		for _, point := range r.DataPoints {
+			counts[point.Values[protocol]]++
+		}
+		var breakdown []string
+		for _, status := range statuses {
+			if counts[status] != 0 {
+				breakdown = append(breakdown, fmt.Sprintf("%s=%d", status, counts[status]))
+			}
+		}
+		percentiles := metric.CalculatePercentiles(r.durations[protocol], 50, 90)
+
+		results = append(results, fmt.Sprintf("%s/%d: %s, dial_P50=%s, dial_P90=%s",
+			protocol,
+			port,
+			strings.Join(breakdown, ", "),
+			percentiles[50],
+			percentiles[90]))
+	}
+
+	return strings.Join(results, " \n ")
+}
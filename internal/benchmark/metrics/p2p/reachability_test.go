@@ -0,0 +1,70 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+// unusedPort returns a port nothing listens on by closing a listener bound to it
+func unusedPort(t *testing.T, network string) uint16 {
+	if network == "udp" {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer conn.Close()
+		return uint16(conn.LocalAddr().(*net.UDPAddr).Port)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return uint16(listener.Addr().(*net.TCPAddr).Port)
+}
+
+func TestReachabilityMetric_Measure(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = conn.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	open := NewReachabilityMetric(metric.SSVGroup, "127.0.0.1",
+		uint16(listener.Addr().(*net.TCPAddr).Port),
+		uint16(conn.LocalAddr().(*net.UDPAddr).Port),
+		"Reachability", time.Second, nil)
+	open.measure(context.Background())
+
+	require.Len(t, open.DataPoints, 1)
+	assert.Equal(t, map[string]string{TCPMeasurement: StatusOpen, UDPMeasurement: StatusOpen}, open.DataPoints[0].Values)
+	assert.Contains(t, open.AggregateResults(), "open=1")
+
+	closed := NewReachabilityMetric(metric.SSVGroup, "127.0.0.1", unusedPort(t, "tcp"), unusedPort(t, "udp"), "Reachability", time.Second, nil)
+	closed.measure(context.Background())
+
+	require.Len(t, closed.DataPoints, 1)
+	assert.Equal(t, map[string]string{TCPMeasurement: StatusClosed, UDPMeasurement: StatusClosed}, closed.DataPoints[0].Values)
+
+	udpDisabled := NewReachabilityMetric(metric.SSVGroup, "127.0.0.1", uint16(listener.Addr().(*net.TCPAddr).Port), 0, "Reachability", time.Second, nil)
+	udpDisabled.measure(context.Background())
+
+	require.Len(t, udpDisabled.DataPoints, 1)
+	assert.NotContains(t, udpDisabled.DataPoints[0].Values, UDPMeasurement)
+	assert.NotContains(t, udpDisabled.AggregateResults(), UDPMeasurement)
+}
@@ -0,0 +1,140 @@
+package ssv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
+)
+
+const (
+	VersionMeasurement       = "Version"
+	VersionPolicyMeasurement = "VersionPolicy"
+)
+
+// identityResponse is the response of '/v1/node/identity'. Subnets is a hex encoded bitmask of the subscribed subnets.
+// The response does not report the network of the node.
+type identityResponse struct {
+	PeerID  string `json:"peer_id"`
+	Subnets string `json:"subnets"`
+	Version string `json:"version"`
+}
+
+// ClientMetric reports the peer ID and the version of the SSV node and evaluates the version against the version policy
+type ClientMetric struct {
+	metric.Base[string]
+	url      string
+	interval time.Duration
+	network  network.Name
+	policy   version.Policy
+	peerID   string
+}
+
+func NewClientMetric(url, name string, interval time.Duration, network network.Name, policy version.Policy, healthCondition []metric.HealthCondition[string]) *ClientMetric {
+	return &ClientMetric{
+		url: url,
+		Base: metric.Base[string]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+		network:  network,
+		policy:   policy,
+	}
+}
+
+func (c *ClientMetric) Measure(ctx context.Context) {
+	c.measure(ctx)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", c.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			c.measure(ctx)
+		}
+	}
+}
+
+func (c *ClientMetric) measure(ctx context.Context) {
+	var resp identityResponse
+	if err := fetch(ctx, fmt.Sprintf("%s/v1/node/identity", c.url), &resp); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		c.writeMetric("", version.StatusUnknown)
+		logger.WriteError(metric.SSVGroup, c.Name, errors.Join(err, errors.New("failed fetching node identity")))
+		return
+	}
+	c.peerID = resp.PeerID
+
+	semver, err := version.ParseSemver(resp.Version)
+	if err != nil {
+		c.writeMetric(resp.Version, version.StatusUnknown)
+		logger.WriteError(metric.SSVGroup, c.Name, errors.Join(err, errors.New("failed parsing node version")))
+		return
+	}
+
+	c.writeMetric(resp.Version, c.policy.Check(version.Info{
+		Raw:     resp.Version,
+		Client:  version.SSV,
+		Version: semver,
+	}, string(c.network)))
+}
+
+func (c *ClientMetric) writeMetric(rawVersion string, status version.Status) {
+	c.AddDataPoint(map[string]string{
+		VersionMeasurement:       rawVersion,
+		VersionPolicyMeasurement: string(status),
+	})
+
+	if rawVersion != "" {
+		// the series of the previous version or status would otherwise keep reporting 1 after an upgrade
+		clientVersionMetric.DeletePartialMatch(serverAddrLabel(c.url))
+		clientVersionMetric.With(clientVersionLabels(c.url, rawVersion, status)).Set(1)
+	}
+
+	logger.WriteMetric(metric.SSVGroup, c.Name, map[string]any{
+		VersionMeasurement:       rawVersion,
+		VersionPolicyMeasurement: status,
+	}, map[string]any{
+		"peer_id": c.peerID,
+	})
+}
+
+func (c *ClientMetric) AggregateResults() string {
+	var (
+		versions []string
+		latest   map[string]string
+	)
+	for _, point := range c.DataPoints {
+		if v := point.Values[VersionMeasurement]; v != "" {
+			latest = point.Values
+			if !slices.Contains(versions, v) {
+				versions = append(versions, v)
+			}
+		}
+	}
+	if len(versions) == 0 {
+		return ""
+	}
+
+	result := fmt.Sprintf("%s, policy=%s, peer_id=%s", latest[VersionMeasurement], latest[VersionPolicyMeasurement], c.peerID)
+	if len(versions) > 1 {
+		result += fmt.Sprintf(" \n observed_versions=[%s]", strings.Join(versions, ", "))
+	}
+
+	return result
+}
@@ -2,15 +2,10 @@ package ssv
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"log/slog"
-	"net/http"
 	"time"
 
-	"github.com/prometheus/client_golang/prometheus"
-
 	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
 	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
 )
@@ -22,13 +17,13 @@ const (
 
 type ConnectionsMetric struct {
 	metric.Base[uint32]
-	url      string
+	poller   *HealthPoller
 	interval time.Duration
 }
 
-func NewConnectionsMetric(url, name string, interval time.Duration, healthCondition []metric.HealthCondition[uint32]) *ConnectionsMetric {
+func NewConnectionsMetric(poller *HealthPoller, name string, interval time.Duration, healthCondition []metric.HealthCondition[uint32]) *ConnectionsMetric {
 	return &ConnectionsMetric{
-		url: url,
+		poller: poller,
 		Base: metric.Base[uint32]{
 			HealthConditions: healthCondition,
 			Name:             name,
@@ -53,48 +48,12 @@ func (p *ConnectionsMetric) Measure(ctx context.Context) {
 }
 
 func (c *ConnectionsMetric) measure(ctx context.Context) {
-	var (
-		resp struct {
-			Advanced struct {
-				Inbound  uint32 `json:"inbound_conns"`
-				Outbound uint32 `json:"outbound_conns"`
-			} `json:"advanced"`
-		}
-	)
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/node/health", c.url), nil)
+	resp, err := c.poller.poll(ctx)
 	if err != nil {
-		logger.WriteError(metric.SSVGroup, c.Name, err)
-		return
-	}
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		if !errors.Is(err, ctx.Err()) {
-			c.writeMetric(0, 0)
-
-			logger.WriteError(metric.SSVGroup, c.Name, err)
+		if ctx.Err() != nil {
+			return
 		}
-		return
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
 		c.writeMetric(0, 0)
-
-		var errorResponse any
-		_ = json.NewDecoder(res.Body).Decode(&errorResponse)
-		jsonErrResponse, _ := json.Marshal(errorResponse)
-		logger.WriteError(
-			metric.SSVGroup,
-			c.Name,
-			fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, jsonErrResponse))
-		return
-	}
-
-	if err = json.NewDecoder(res.Body).Decode(&resp); err != nil {
-		c.writeMetric(0, 0)
-
 		logger.WriteError(metric.SSVGroup, c.Name, err)
 		return
 	}
@@ -108,8 +67,8 @@ func (c *ConnectionsMetric) writeMetric(inbound, outbound uint32) {
 		OutboundConnectionsMeasurement: outbound,
 	})
 
-	connectionsMetric.With(prometheus.Labels{connectionDirectionLabel: "inbound"}).Set(float64(inbound))
-	connectionsMetric.With(prometheus.Labels{connectionDirectionLabel: "outbound"}).Set(float64(outbound))
+	connectionsMetric.With(connectionDirectionLabels(c.poller.url, "inbound")).Set(float64(inbound))
+	connectionsMetric.With(connectionDirectionLabels(c.poller.url, "outbound")).Set(float64(outbound))
 
 	logger.WriteMetric(metric.SSVGroup, c.Name, map[string]any{
 		InboundConnectionsMeasurement:  inbound,
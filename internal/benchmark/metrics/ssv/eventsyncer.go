@@ -0,0 +1,136 @@
+package ssv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/execution"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	SyncerBlockLagMeasurement = "BlockLag"
+	SyncerTimeLagMeasurement  = "TimeLag"
+)
+
+// EventSyncerMetric compares the last block the SSV node processed the SSV network contract events of with the head of the
+// execution clients. A lagging event syncer does not pick up new validators and cluster changes. The last processed block is
+// read from the metrics exposed by the node, the time lag is the difference between the timestamps of the two blocks in seconds.
+type EventSyncerMetric struct {
+	metric.Base[float64]
+	url           string
+	metricsURL    string
+	executionURLs []string
+	selector      Selector
+	interval      time.Duration
+	lastProcessed uint64
+}
+
+// NewEventSyncerMetric scrapes the metrics URL of the node, its series are labelled with the SSV API address url
+func NewEventSyncerMetric(url, metricsURL string, executionURLs []string, name string, interval time.Duration, selector Selector, healthCondition []metric.HealthCondition[float64]) *EventSyncerMetric {
+	return &EventSyncerMetric{
+		url:           url,
+		metricsURL:    metricsURL,
+		executionURLs: executionURLs,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		selector: selector,
+		interval: interval,
+	}
+}
+
+func (e *EventSyncerMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", e.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			e.measure(ctx)
+		}
+	}
+}
+
+func (e *EventSyncerMetric) measure(ctx context.Context) {
+	families, err := scrape(ctx, fmt.Sprintf("%s/metrics", e.metricsURL))
+	if err != nil {
+		logger.WriteError(metric.SSVGroup, e.Name, errors.Join(err, errors.New("failed scraping node metrics")))
+		return
+	}
+	value, ok := e.selector.Sum(families)
+	if !ok {
+		logger.WriteError(metric.SSVGroup, e.Name, fmt.Errorf("no series matched the last processed block selector: '%s'", e.selector))
+		return
+	}
+	lastProcessed := uint64(value)
+
+	head, err := execution.FetchBlock(ctx, e.executionURLs, "latest")
+	if err != nil {
+		logger.WriteError(metric.SSVGroup, e.Name, errors.Join(err, errors.New("failed fetching execution head block")))
+		return
+	}
+
+	var blockLag, timeLag float64
+	if head.Number > lastProcessed {
+		processed, err := execution.FetchBlock(ctx, e.executionURLs, fmt.Sprintf("0x%x", lastProcessed))
+		if err != nil {
+			logger.WriteError(metric.SSVGroup, e.Name, errors.Join(err, fmt.Errorf("failed fetching last processed block: '%d'", lastProcessed)))
+			return
+		}
+		blockLag = float64(head.Number - lastProcessed)
+		if head.Timestamp > processed.Timestamp {
+			timeLag = float64(head.Timestamp - processed.Timestamp)
+		}
+	}
+
+	e.writeMetric(lastProcessed, head.Number, map[string]float64{
+		SyncerBlockLagMeasurement: blockLag,
+		SyncerTimeLagMeasurement:  timeLag,
+	})
+}
+
+func (e *EventSyncerMetric) writeMetric(lastProcessed, head uint64, values map[string]float64) {
+	e.lastProcessed = lastProcessed
+	e.AddDataPoint(values)
+
+	eventSyncerBlockLagMetric.With(serverAddrLabel(e.url)).Set(values[SyncerBlockLagMeasurement])
+	eventSyncerTimeLagMetric.With(serverAddrLabel(e.url)).Set(values[SyncerTimeLagMeasurement])
+
+	logger.WriteMetric(metric.SSVGroup, e.Name, map[string]any{
+		SyncerBlockLagMeasurement: values[SyncerBlockLagMeasurement],
+		SyncerTimeLagMeasurement:  values[SyncerTimeLagMeasurement],
+	}, map[string]any{
+		"last_processed_block": lastProcessed,
+		"head_block":           head,
+	})
+}
+
+func (e *EventSyncerMetric) AggregateResults() string {
+	if len(e.DataPoints) == 0 {
+		return ""
+	}
+
+	var blockLags, timeLags []float64
+	for _, point := range e.DataPoints {
+		blockLags = append(blockLags, point.Values[SyncerBlockLagMeasurement])
+		timeLags = append(timeLags, point.Values[SyncerTimeLagMeasurement])
+	}
+	blockPercentiles := metric.CalculatePercentiles(blockLags, 50, 100)
+	timePercentiles := metric.CalculatePercentiles(timeLags, 50, 100)
+
+	return fmt.Sprintf("block_lag_P50=%.0f, block_lag_max=%.0f, time_lag_P50=%s, time_lag_max=%s, last_processed_block=%d",
+		blockPercentiles[50],
+		blockPercentiles[100],
+		time.Duration(timePercentiles[50])*time.Second,
+		time.Duration(timePercentiles[100])*time.Second,
+		e.lastProcessed)
+}
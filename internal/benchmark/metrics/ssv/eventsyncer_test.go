@@ -0,0 +1,58 @@
+package ssv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventSyncerMetric_Measure(t *testing.T) {
+	const headNumber = 1010
+
+	ssvServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("# TYPE ssv_event_syncer_last_processed_block gauge\nssv_event_syncer_last_processed_block 1000\n"))
+	}))
+	defer ssvServer.Close()
+
+	executionServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			return
+		}
+		assert.Equal(t, "eth_getBlockByNumber", req.Method)
+
+		number := uint64(headNumber)
+		if tag := req.Params[0].(string); tag != "latest" {
+			var err error
+			number, err = strconv.ParseUint(strings.TrimPrefix(tag, "0x"), 16, 64)
+			if !assert.NoError(t, err) {
+				return
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":{"number":"0x%x","hash":"0x01","timestamp":"0x%x"}}`, number, number*12)
+	}))
+	defer executionServer.Close()
+
+	selector, err := ParseSelector("ssv_event_syncer_last_processed_block")
+	require.NoError(t, err)
+
+	metric := NewEventSyncerMetric("http://127.0.0.1:16000", ssvServer.URL, []string{"http://127.0.0.1:1", executionServer.URL}, "EventSyncer", time.Second, selector, nil)
+	metric.measure(context.Background())
+
+	require.Len(t, metric.DataPoints, 1)
+	assert.Equal(t, 10.0, metric.DataPoints[0].Values[SyncerBlockLagMeasurement])
+	assert.Equal(t, 120.0, metric.DataPoints[0].Values[SyncerTimeLagMeasurement])
+	assert.Contains(t, metric.AggregateResults(), "last_processed_block=1000")
+}
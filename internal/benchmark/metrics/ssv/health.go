@@ -0,0 +1,163 @@
+package ssv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	P2PMeasurement           = "P2P"
+	BeaconNodeMeasurement    = "BeaconNode"
+	ExecutionNodeMeasurement = "ExecutionNode"
+	EventSyncerMeasurement   = "EventSyncer"
+	TransitionMeasurement    = "Transition"
+)
+
+// healthComponents lists the component measurements in the order they are reported
+var healthComponents = []string{P2PMeasurement, BeaconNodeMeasurement, ExecutionNodeMeasurement, EventSyncerMeasurement}
+
+type (
+	healthTransition struct {
+		at        time.Time
+		component string
+		from, to  uint32
+	}
+
+	// HealthMetric reports the status of every component of the SSV node along with its peers and connections.
+	// Component measurements are 1 when the node reports the component as healthy and 0 otherwise.
+	HealthMetric struct {
+		metric.Base[uint32]
+		poller      *HealthPoller
+		interval    time.Duration
+		transitions []healthTransition
+	}
+)
+
+func NewHealthMetric(poller *HealthPoller, name string, interval time.Duration, healthCondition []metric.HealthCondition[uint32]) *HealthMetric {
+	return &HealthMetric{
+		poller: poller,
+		Base: metric.Base[uint32]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+	}
+}
+
+func (h *HealthMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", h.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			h.measure(ctx)
+		}
+	}
+}
+
+func (h *HealthMetric) measure(ctx context.Context) {
+	resp, err := h.poller.poll(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		// an unreachable node is reported with all of its components unhealthy
+		h.writeMetric(healthResponse{})
+		logger.WriteError(metric.SSVGroup, h.Name, errors.Join(err, errors.New("failed fetching node health")))
+		return
+	}
+
+	h.writeMetric(resp)
+}
+
+func componentStatus(status string) uint32 {
+	if strings.EqualFold(status, healthyStatus) {
+		return 1
+	}
+	return 0
+}
+
+func (h *HealthMetric) writeMetric(resp healthResponse) {
+	values := map[string]uint32{
+		P2PMeasurement:                 componentStatus(resp.P2P),
+		BeaconNodeMeasurement:          componentStatus(resp.BeaconNode),
+		ExecutionNodeMeasurement:       componentStatus(resp.ExecutionNode),
+		EventSyncerMeasurement:         componentStatus(resp.EventSyncer),
+		PeerCountMeasurement:           resp.Advanced.Peers,
+		InboundConnectionsMeasurement:  resp.Advanced.Inbound,
+		OutboundConnectionsMeasurement: resp.Advanced.Outbound,
+	}
+
+	if len(h.DataPoints) != 0 {
+		previous := h.DataPoints[len(h.DataPoints)-1].Values
+		for _, component := range healthComponents {
+			if previous[component] != values[component] {
+				values[TransitionMeasurement] = 1
+				h.transitions = append(h.transitions, healthTransition{at: time.Now(), component: component, from: previous[component], to: values[component]})
+				nodeHealthTransitionsMetric.With(componentLabels(h.poller.url, component)).Inc()
+			}
+		}
+	}
+
+	h.AddDataPoint(values)
+
+	for _, component := range healthComponents {
+		nodeHealthMetric.With(componentLabels(h.poller.url, component)).Set(float64(values[component]))
+	}
+
+	logger.WriteMetric(metric.SSVGroup, h.Name, map[string]any{
+		P2PMeasurement:                 resp.P2P,
+		BeaconNodeMeasurement:          resp.BeaconNode,
+		ExecutionNodeMeasurement:       resp.ExecutionNode,
+		EventSyncerMeasurement:         resp.EventSyncer,
+		PeerCountMeasurement:           resp.Advanced.Peers,
+		InboundConnectionsMeasurement:  resp.Advanced.Inbound,
+		OutboundConnectionsMeasurement: resp.Advanced.Outbound,
+	})
+}
+
+func (h *HealthMetric) AggregateResults() string {
+	if len(h.DataPoints) == 0 {
+		return ""
+	}
+
+	var healthy []string
+	for _, component := range healthComponents {
+		var count float64
+		for _, point := range h.DataPoints {
+			count += float64(point.Values[component])
+		}
+		healthy = append(healthy, fmt.Sprintf("%s=%.2f%%", component, count/float64(len(h.DataPoints))*100))
+	}
+
+	result := fmt.Sprintf("healthy: %s, transitions=%d", strings.Join(healthy, ", "), len(h.transitions))
+
+	if len(h.transitions) != 0 {
+		var transitions []string
+		// only the most recent transitions are listed to keep the report readable
+		for _, t := range h.transitions[max(0, len(h.transitions)-3):] {
+			transitions = append(transitions, fmt.Sprintf("%s %s: %s->%s", t.at.Format(time.TimeOnly), t.component, statusName(t.from), statusName(t.to)))
+		}
+		result += fmt.Sprintf(" \n last_transitions=[%s]", strings.Join(transitions, ", "))
+	}
+
+	return result
+}
+
+func statusName(status uint32) string {
+	if status == 1 {
+		return healthyStatus
+	}
+	return "bad"
+}
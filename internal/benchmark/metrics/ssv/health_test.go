@@ -0,0 +1,59 @@
+package ssv
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthMetric_SharedPoll(t *testing.T) {
+	var (
+		requests atomic.Int32
+		healthy  atomic.Bool
+	)
+	healthy.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/node/health", r.URL.Path)
+		requests.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		if healthy.Load() {
+			_, _ = w.Write([]byte(`{"p2p":"good","beacon_node":"good","execution_node":"good","event_syncer":"good","advanced":{"peers":42,"inbound_conns":12,"outbound_conns":30}}`))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"p2p":"good","beacon_node":"good","execution_node":"bad","event_syncer":"good","advanced":{"peers":40,"inbound_conns":10,"outbound_conns":30}}`))
+	}))
+	defer server.Close()
+
+	poller := NewHealthPoller(server.URL, time.Second)
+	healthMetric := NewHealthMetric(poller, "Health", time.Second, nil)
+	peerMetric := NewPeerMetric(poller, "Peers", time.Second, nil)
+	connectionsMetric := NewConnectionsMetric(poller, "Connections", time.Second, nil)
+
+	healthMetric.measure(context.Background())
+	peerMetric.measure(context.Background())
+	connectionsMetric.measure(context.Background())
+
+	assert.Equal(t, int32(1), requests.Load())
+	assert.Equal(t, uint32(42), peerMetric.DataPoints[0].Values[PeerCountMeasurement])
+	assert.Equal(t, uint32(12), connectionsMetric.DataPoints[0].Values[InboundConnectionsMeasurement])
+
+	healthy.Store(false)
+	time.Sleep(600 * time.Millisecond)
+	healthMetric.measure(context.Background())
+
+	assert.Equal(t, int32(2), requests.Load())
+	require.Len(t, healthMetric.DataPoints, 2)
+	values := healthMetric.DataPoints[1].Values
+	assert.Equal(t, uint32(0), values[ExecutionNodeMeasurement])
+	assert.Equal(t, uint32(1), values[BeaconNodeMeasurement])
+	assert.Equal(t, uint32(1), values[TransitionMeasurement])
+	assert.Contains(t, healthMetric.AggregateResults(), "ExecutionNode: good->bad")
+}
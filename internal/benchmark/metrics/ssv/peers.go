@@ -2,12 +2,7 @@ package ssv
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
 	"log/slog"
-	"net/http"
 	"time"
 
 	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
@@ -20,13 +15,13 @@ const (
 
 type PeerMetric struct {
 	metric.Base[uint32]
-	url      string
+	poller   *HealthPoller
 	interval time.Duration
 }
 
-func NewPeerMetric(url, name string, interval time.Duration, healthCondition []metric.HealthCondition[uint32]) *PeerMetric {
+func NewPeerMetric(poller *HealthPoller, name string, interval time.Duration, healthCondition []metric.HealthCondition[uint32]) *PeerMetric {
 	return &PeerMetric{
-		url: url,
+		poller: poller,
 		Base: metric.Base[uint32]{
 			HealthConditions: healthCondition,
 			Name:             name,
@@ -51,35 +46,11 @@ func (p *PeerMetric) Measure(ctx context.Context) {
 }
 
 func (p *PeerMetric) measure(ctx context.Context) {
-	var (
-		resp struct {
-			Advanced struct {
-				Peers uint32 `json:"peers"`
-			} `json:"advanced"`
-		}
-	)
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/node/health", p.url), nil)
+	resp, err := p.poller.poll(ctx)
 	if err != nil {
-		logger.WriteError(metric.SSVGroup, p.Name, err)
-		return
-	}
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		p.writeMetric(0)
-		logger.WriteError(metric.SSVGroup, p.Name, err)
-		return
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		p.writeMetric(0)
-		p.logErrorResponse(res)
-		return
-	}
-
-	if err = json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
 		p.writeMetric(0)
 		logger.WriteError(metric.SSVGroup, p.Name, err)
 		return
@@ -88,49 +59,11 @@ func (p *PeerMetric) measure(ctx context.Context) {
 	p.writeMetric(resp.Advanced.Peers)
 }
 
-func (p *PeerMetric) logErrorResponse(res *http.Response) {
-	var responseString string
-	if res.Header.Get("Content-Type") == "application/json" {
-		var errorResponse any
-		if err := json.NewDecoder(res.Body).Decode(&errorResponse); err != nil {
-			logger.WriteError(
-				metric.SSVGroup,
-				p.Name,
-				errors.Join(err, fmt.Errorf("received unsuccessful status code. Code: '%s'. Failed to JSON decode response", res.Status)))
-			return
-		}
-		jsonErrResponse, err := json.Marshal(errorResponse)
-		if err != nil {
-			logger.WriteError(
-				metric.SSVGroup,
-				p.Name,
-				errors.Join(err, fmt.Errorf("received unsuccessful status code. Code: '%s'. Failed to marshal response", res.Status)))
-			return
-		}
-		responseString = string(jsonErrResponse)
-	} else {
-		body, err := io.ReadAll(res.Body)
-		if err != nil {
-			logger.WriteError(
-				metric.SSVGroup,
-				p.Name,
-				errors.Join(err, fmt.Errorf("received unsuccessful status code. Code: '%s'. Failed to decode response", res.Status)))
-			return
-		}
-		responseString = string(body)
-	}
-
-	logger.WriteError(
-		metric.SSVGroup,
-		p.Name,
-		fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, responseString))
-}
-
 func (p *PeerMetric) writeMetric(value uint32) {
 	p.AddDataPoint(map[string]uint32{
 		PeerCountMeasurement: value,
 	})
-	peerCountMetric.Set(float64(value))
+	peerCountMetric.With(serverAddrLabel(p.poller.url)).Set(float64(value))
 
 	logger.WriteMetric(metric.SSVGroup, p.Name, map[string]any{PeerCountMeasurement: value})
 }
@@ -0,0 +1,100 @@
+package ssv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const healthyStatus = "good"
+
+// healthResponse is the response of '/v1/node/health'. Component statuses are either 'good' or 'bad'.
+type healthResponse struct {
+	P2P           string `json:"p2p"`
+	BeaconNode    string `json:"beacon_node"`
+	ExecutionNode string `json:"execution_node"`
+	EventSyncer   string `json:"event_syncer"`
+	Advanced      struct {
+		Peers    uint32 `json:"peers"`
+		Inbound  uint32 `json:"inbound_conns"`
+		Outbound uint32 `json:"outbound_conns"`
+	} `json:"advanced"`
+}
+
+// HealthPoller fetches '/v1/node/health' on behalf of all the metrics reading it. A response is reused for half of the
+// polling interval, so metrics polling with the same interval share a single request.
+type HealthPoller struct {
+	url       string
+	maxAge    time.Duration
+	mu        sync.Mutex
+	fetchedAt time.Time
+	resp      healthResponse
+	err       error
+}
+
+func NewHealthPoller(url string, interval time.Duration) *HealthPoller {
+	return &HealthPoller{
+		url:    url,
+		maxAge: interval / 2,
+	}
+}
+
+func (h *HealthPoller) poll(ctx context.Context) (healthResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if !h.fetchedAt.IsZero() && time.Since(h.fetchedAt) < h.maxAge {
+		return h.resp, h.err
+	}
+
+	h.resp, h.err = h.fetch(ctx)
+	if ctx.Err() != nil {
+		// a cancelled request must not be served to the other metrics
+		return h.resp, h.err
+	}
+	h.fetchedAt = time.Now()
+
+	return h.resp, h.err
+}
+
+// fetch decodes the response regardless of the status code, since the SSV node responds with
+// an error status code along with the component statuses when any of the components is unhealthy
+func (h *HealthPoller) fetch(ctx context.Context) (healthResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/node/health", h.url), nil)
+	if err != nil {
+		return healthResponse{}, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return healthResponse{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return healthResponse{}, errors.Join(err, errors.New("failed reading node health response"))
+	}
+
+	var resp healthResponse
+	if res.StatusCode != http.StatusOK {
+		if err := json.Unmarshal(body, &resp); err != nil || resp.P2P == "" {
+			return healthResponse{}, fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, body)
+		}
+		return resp, nil
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return healthResponse{}, errors.Join(err, errors.New("failed decoding node health response"))
+	}
+
+	return resp, nil
+}
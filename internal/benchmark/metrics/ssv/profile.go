@@ -0,0 +1,180 @@
+package ssv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const ProfilesMeasurement = "Profiles"
+
+// profiles lists the captured pprof profiles along with their paths relative to the pprof endpoint
+var profiles = []struct {
+	name string
+	path string
+}{
+	{name: "cpu", path: "/debug/pprof/profile?seconds=%d"},
+	{name: "heap", path: "/debug/pprof/heap"},
+	{name: "goroutine", path: "/debug/pprof/goroutine"},
+}
+
+type profileTrigger struct {
+	metricName string
+	severity   metric.SeverityLevel
+}
+
+// ProfileMetric captures CPU, heap and goroutine profiles of the SSV node when one of the metrics it is registered with
+// reaches High severity, so that the node can be profiled while it is unhealthy. Captures are at least the cooldown
+// apart and triggers arriving during a capture are dropped to avoid overloading the node.
+type ProfileMetric struct {
+	metric.Base[uint32]
+	url         string
+	outputDir   string
+	filePrefix  string
+	cpuDuration time.Duration
+	cooldown    time.Duration
+	triggers    chan profileTrigger
+	lastCapture time.Time
+	files       []string
+}
+
+func NewProfileMetric(url, name, outputDir, filePrefix string, cpuDuration, cooldown time.Duration) *ProfileMetric {
+	return &ProfileMetric{
+		url: url,
+		Base: metric.Base[uint32]{
+			Name: name,
+		},
+		outputDir:   outputDir,
+		filePrefix:  filePrefix,
+		cpuDuration: cpuDuration,
+		cooldown:    cooldown,
+		triggers:    make(chan profileTrigger, 1),
+	}
+}
+
+// Trigger requests a capture when the severity is High. It is a metric.SeverityHook and never blocks the measuring metric.
+func (p *ProfileMetric) Trigger(metricName string, severity metric.SeverityLevel) {
+	if severity != metric.SeverityHigh {
+		return
+	}
+	select {
+	case p.triggers <- profileTrigger{metricName: metricName, severity: severity}:
+	default:
+	}
+}
+
+func (p *ProfileMetric) Measure(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", p.Name).Debug("metric was stopped")
+			return
+		case trigger := <-p.triggers:
+			if !p.lastCapture.IsZero() && time.Since(p.lastCapture) < p.cooldown {
+				slog.
+					With("metric_name", p.Name).
+					With("trigger", trigger.metricName).
+					Debug("profile capture was skipped during cooldown")
+				continue
+			}
+			p.capture(ctx, trigger)
+		}
+	}
+}
+
+func (p *ProfileMetric) capture(ctx context.Context, trigger profileTrigger) {
+	p.lastCapture = time.Now()
+	if err := os.MkdirAll(p.outputDir, 0o755); err != nil {
+		logger.WriteError(metric.SSVGroup, p.Name, errors.Join(err, errors.New("failed creating profiles output directory")))
+		return
+	}
+
+	timestamp := p.lastCapture.UTC().Format("20060102T150405Z")
+	var files []string
+	for _, profile := range profiles {
+		path := profile.path
+		if profile.name == "cpu" {
+			path = fmt.Sprintf(path, int(p.cpuDuration.Seconds()))
+		}
+		file := filepath.Join(p.outputDir, fmt.Sprintf("%s-%s-%s.pb.gz", p.filePrefix, timestamp, profile.name))
+
+		if err := p.download(ctx, p.url+path, file); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.WriteError(metric.SSVGroup, p.Name, errors.Join(err, fmt.Errorf("failed capturing '%s' profile", profile.name)))
+			continue
+		}
+		files = append(files, file)
+	}
+	p.files = append(p.files, files...)
+
+	p.writeMetric(trigger, files)
+}
+
+func (p *ProfileMetric) download(ctx context.Context, url, file string) error {
+	// the CPU profile is only returned once it was collected for the requested duration
+	ctx, cancel := context.WithTimeout(ctx, p.cpuDuration+requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, resBody)
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, res.Body); err != nil {
+		f.Close()
+		os.Remove(file)
+		return err
+	}
+	return f.Close()
+}
+
+func (p *ProfileMetric) writeMetric(trigger profileTrigger, files []string) {
+	p.AddDataPoint(map[string]uint32{
+		ProfilesMeasurement: uint32(len(files)),
+	})
+
+	profileCapturesMetric.With(serverAddrLabel(p.url)).Inc()
+
+	logger.WriteMetric(metric.SSVGroup, p.Name, map[string]any{
+		ProfilesMeasurement: len(files),
+	}, map[string]any{
+		"trigger":  trigger.metricName,
+		"severity": trigger.severity,
+		"files":    strings.Join(files, ","),
+	})
+}
+
+func (p *ProfileMetric) AggregateResults() string {
+	if len(p.DataPoints) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("captures=%d \n files=[%s]", len(p.DataPoints), strings.Join(p.files, ", "))
+}
@@ -0,0 +1,68 @@
+package ssv
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+func TestProfileMetric_Measure(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.URL.Path {
+		case "/debug/pprof/profile":
+			assert.Equal(t, "1", r.URL.Query().Get("seconds"))
+		case "/debug/pprof/heap", "/debug/pprof/goroutine":
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte("profile"))
+	}))
+	defer server.Close()
+
+	outputDir := filepath.Join(t.TempDir(), "profiles")
+	profiler := NewProfileMetric(server.URL, "Profiling", outputDir, "ssv-1", time.Second, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		profiler.Measure(ctx)
+		close(done)
+	}()
+
+	// lower severities do not trigger a capture
+	profiler.Trigger("Health", metric.SeverityMedium)
+	profiler.Trigger("Health", metric.SeverityHigh)
+	require.Eventually(t, func() bool { return requests.Load() == 3 }, 5*time.Second, 10*time.Millisecond)
+
+	// captures during the cooldown are skipped
+	profiler.Trigger("Health", metric.SeverityHigh)
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	<-done
+
+	assert.Equal(t, int32(3), requests.Load())
+	require.Len(t, profiler.DataPoints, 1)
+	assert.Equal(t, uint32(3), profiler.DataPoints[0].Values[ProfilesMeasurement])
+
+	entries, err := os.ReadDir(outputDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for _, file := range profiler.files {
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		assert.Equal(t, "profile", string(content))
+	}
+	assert.Contains(t, profiler.AggregateResults(), "captures=1")
+}
@@ -1,24 +1,36 @@
 package ssv
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
 )
 
 const (
 	namespace                = "pulse"
 	subsystem                = "ssv"
+	serverAddrLabelName      = "server_address"
 	connectionDirectionLabel = "direction"
+	componentLabelName       = "component"
+	versionLabelName         = "version"
+	statusLabelName          = "status"
+	subnetLabelName          = "subnet"
+	measurementLabelName     = "measurement"
 )
 
 var (
-	peerCountMetric = promauto.NewGauge(
+	labels = []string{serverAddrLabelName}
+
+	peerCountMetric = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name:      "peer_count",
 			Help:      "number of peers",
 			Namespace: namespace,
 			Subsystem: subsystem,
-		})
+		}, labels)
 
 	connectionsMetric = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -26,5 +38,119 @@ var (
 			Help:      "number of connection",
 			Namespace: namespace,
 			Subsystem: subsystem,
-		}, []string{connectionDirectionLabel})
+		}, []string{serverAddrLabelName, connectionDirectionLabel})
+
+	nodeHealthMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "node_health",
+			Help:      "set to 1 when the node reports the component as healthy",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, componentLabelName})
+
+	nodeHealthTransitionsMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "node_health_transitions",
+			Help:      "number of component health status changes",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, componentLabelName})
+
+	clientVersionMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "client_version",
+			Help:      "version reported by the node along with its version policy status",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, versionLabelName, statusLabelName})
+
+	subscribedSubnetsMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "subscribed_subnets",
+			Help:      "number of subnets the node is subscribed to",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	subnetPeersMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "subnet_peers",
+			Help:      "number of peers in a subnet the node is subscribed to",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, subnetLabelName})
+
+	scrapedMeasurementMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "scraped_measurement",
+			Help:      "measurement derived from the metrics exposed by the node",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, []string{serverAddrLabelName, measurementLabelName})
+
+	eventSyncerBlockLagMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "event_syncer_block_lag",
+			Help:      "number of blocks the last block processed by the event syncer is behind the execution head",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	eventSyncerTimeLagMetric = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      "event_syncer_time_lag_seconds",
+			Help:      "timestamp difference between the execution head and the last block processed by the event syncer",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
+
+	profileCapturesMetric = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name:      "profile_captures",
+			Help:      "number of profile captures triggered by metrics reaching High severity",
+			Namespace: namespace,
+			Subsystem: subsystem,
+		}, labels)
 )
+
+func serverAddrLabel(serverAddr string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+	}
+}
+
+func connectionDirectionLabels(serverAddr, direction string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName:      serverAddr,
+		connectionDirectionLabel: direction,
+	}
+}
+
+func componentLabels(serverAddr, component string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		componentLabelName:  component,
+	}
+}
+
+func clientVersionLabels(serverAddr, version string, status version.Status) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		versionLabelName:    version,
+		statusLabelName:     string(status),
+	}
+}
+
+func subnetLabels(serverAddr string, subnet int) map[string]string {
+	return map[string]string{
+		serverAddrLabelName: serverAddr,
+		subnetLabelName:     strconv.Itoa(subnet),
+	}
+}
+
+func measurementLabels(serverAddr, measurement string) map[string]string {
+	return map[string]string{
+		serverAddrLabelName:  serverAddr,
+		measurementLabelName: measurement,
+	}
+}
@@ -0,0 +1,35 @@
+package ssv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const requestTimeout = 5 * time.Second
+
+func fetch(ctx context.Context, url string, resp any) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, resBody)
+	}
+
+	return json.NewDecoder(res.Body).Decode(resp)
+}
@@ -0,0 +1,266 @@
+package ssv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+type ScrapeType string
+
+const (
+	// ScrapeValue reports the sum of the selected series
+	ScrapeValue ScrapeType = "value"
+	// ScrapeIncrease reports the increase of the selected counters since the previous scrape
+	ScrapeIncrease ScrapeType = "increase"
+	// ScrapeRatio reports the increase of the selected counters as a percentage of the increase of the total counters
+	ScrapeRatio ScrapeType = "ratio"
+)
+
+// ScrapeMeasurement derives a measurement from the metrics exposed by the SSV node
+type ScrapeMeasurement struct {
+	Name     string
+	Type     ScrapeType
+	Selector Selector
+	// Total selects the denominator of ScrapeRatio measurements
+	Total Selector
+}
+
+func NewScrapeMeasurement(name, measurementType, selector, total string) (ScrapeMeasurement, error) {
+	measurement := ScrapeMeasurement{
+		Name: name,
+		Type: ScrapeType(strings.ToLower(measurementType)),
+	}
+	if !slices.Contains([]ScrapeType{ScrapeValue, ScrapeIncrease, ScrapeRatio}, measurement.Type) {
+		return ScrapeMeasurement{}, fmt.Errorf("measurement: '%s' had an unsupported type: '%s'", name, measurementType)
+	}
+
+	var err error
+	if measurement.Selector, err = ParseSelector(selector); err != nil {
+		return ScrapeMeasurement{}, errors.Join(err, fmt.Errorf("measurement: '%s' had an invalid selector", name))
+	}
+	if measurement.Type == ScrapeRatio {
+		if measurement.Total, err = ParseSelector(total); err != nil {
+			return ScrapeMeasurement{}, errors.Join(err, fmt.Errorf("measurement: '%s' had an invalid total selector", name))
+		}
+	}
+
+	return measurement, nil
+}
+
+// ScrapeMetric scrapes the Prometheus endpoint of the SSV node and derives the configured measurements from it, e.g.
+// the duty success ratio or the number of rejected messages. Measurements whose selectors match no series are not reported.
+type ScrapeMetric struct {
+	metric.Base[float64]
+	url          string
+	metricsURL   string
+	interval     time.Duration
+	measurements []ScrapeMeasurement
+	previous     map[string]float64
+	missing      []string
+}
+
+// NewScrapeMetric scrapes the metrics URL of the node, its series are labelled with the SSV API address url
+func NewScrapeMetric(url, metricsURL, name string, interval time.Duration, measurements []ScrapeMeasurement, healthCondition []metric.HealthCondition[float64]) *ScrapeMetric {
+	return &ScrapeMetric{
+		url:        url,
+		metricsURL: metricsURL,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval:     interval,
+		measurements: measurements,
+		previous:     make(map[string]float64),
+	}
+}
+
+func (s *ScrapeMetric) Measure(ctx context.Context) {
+	s.measure(ctx)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", s.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			s.measure(ctx)
+		}
+	}
+}
+
+func (s *ScrapeMetric) measure(ctx context.Context) {
+	families, err := scrape(ctx, fmt.Sprintf("%s/metrics", s.metricsURL))
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.WriteError(metric.SSVGroup, s.Name, errors.Join(err, errors.New("failed scraping node metrics")))
+		}
+		return
+	}
+
+	// the selected series are summed once per scrape, increases are calculated against the sums of the previous scrape
+	sums := make(map[string]float64)
+	sum := func(selector Selector) (float64, bool) {
+		if value, ok := sums[selector.String()]; ok {
+			return value, true
+		}
+		value, ok := selector.Sum(families)
+		if ok {
+			sums[selector.String()] = value
+		}
+		return value, ok
+	}
+
+	values := make(map[string]float64)
+	var missing []string
+	for _, measurement := range s.measurements {
+		value, ok := s.derive(measurement, sum)
+		if !ok {
+			if _, found := sum(measurement.Selector); !found {
+				missing = append(missing, measurement.Name)
+			}
+			continue
+		}
+		values[measurement.Name] = value
+	}
+	s.previous = sums
+	s.missing = missing
+
+	s.writeMetric(values)
+}
+
+// derive returns the value of the measurement. Increases and ratios are only known from the second scrape on.
+func (s *ScrapeMetric) derive(measurement ScrapeMeasurement, sum func(Selector) (float64, bool)) (float64, bool) {
+	current, ok := sum(measurement.Selector)
+	if !ok {
+		return 0, false
+	}
+	if measurement.Type == ScrapeValue {
+		return current, true
+	}
+
+	increase, ok := s.increase(measurement.Selector, current)
+	if measurement.Type == ScrapeIncrease {
+		return increase, ok
+	}
+
+	// the total is summed even without a previous scrape to serve as the baseline of the next one
+	total, totalOk := sum(measurement.Total)
+	if !ok || !totalOk {
+		return 0, false
+	}
+	totalIncrease, ok := s.increase(measurement.Total, total)
+	if !ok || totalIncrease == 0 {
+		return 0, false
+	}
+
+	return increase / totalIncrease * 100, true
+}
+
+// increase returns the increase of the counters since the previous scrape, a decrease is handled as a counter reset
+func (s *ScrapeMetric) increase(selector Selector, current float64) (float64, bool) {
+	previous, ok := s.previous[selector.String()]
+	if !ok {
+		return 0, false
+	}
+	if current < previous {
+		return current, true
+	}
+	return current - previous, true
+}
+
+func (s *ScrapeMetric) writeMetric(values map[string]float64) {
+	if len(values) == 0 {
+		return
+	}
+	s.AddDataPoint(values)
+
+	fields := make(map[string]any)
+	for name, value := range values {
+		scrapedMeasurementMetric.With(measurementLabels(s.url, name)).Set(value)
+		fields[name] = value
+	}
+
+	logger.WriteMetric(metric.SSVGroup, s.Name, fields)
+}
+
+func (s *ScrapeMetric) AggregateResults() string {
+	if len(s.DataPoints) == 0 {
+		return ""
+	}
+
+	var results []string
+	for _, measurement := range s.measurements {
+		var values []float64
+		for _, point := range s.DataPoints {
+			if value, ok := point.Values[measurement.Name]; ok {
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		switch measurement.Type {
+		case ScrapeValue:
+			results = append(results, fmt.Sprintf("%s=%.2f (max=%.2f)", measurement.Name, values[len(values)-1], slices.Max(values)))
+		case ScrapeIncrease:
+			var total float64
+			for _, value := range values {
+				total += value
+			}
+			results = append(results, fmt.Sprintf("%s=%.0f", measurement.Name, total))
+		case ScrapeRatio:
+			percentiles := metric.CalculatePercentiles(values, 0, 50)
+			results = append(results, fmt.Sprintf("%s_min=%.2f%%, %s_P50=%.2f%%", measurement.Name, percentiles[0], measurement.Name, percentiles[50]))
+		}
+	}
+
+	result := strings.Join(results, ", ")
+	if len(s.missing) != 0 {
+		result += fmt.Sprintf(" \n not_found=[%s]", strings.Join(s.missing, ", "))
+	}
+
+	return result
+}
+
+func scrape(ctx context.Context, url string) (map[string]*dto.MetricFamily, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	// the text format is requested explicitly as it is the only format supported by the parser
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		resBody, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("received unsuccessful status code. Code: '%s'. Response: '%s'", res.Status, resBody)
+	}
+
+	var parser expfmt.TextParser
+	return parser.TextToMetricFamilies(res.Body)
+}
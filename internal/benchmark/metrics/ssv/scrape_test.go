@@ -0,0 +1,95 @@
+package ssv
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const scrapeResponse = `# HELP ssv_validator_roles_submitted Submitted roles
+# TYPE ssv_validator_roles_submitted counter
+ssv_validator_roles_submitted{role="ATTESTER"} %d
+ssv_validator_roles_submitted{role="PROPOSER"} 2
+# HELP ssv_validator_roles_failed Failed roles
+# TYPE ssv_validator_roles_failed counter
+ssv_validator_roles_failed{role="ATTESTER"} %d
+# HELP ssv_validators_active Active validators
+# TYPE ssv_validators_active gauge
+ssv_validators_active 4
+# HELP ssv_duty_duration_seconds Duty duration
+# TYPE ssv_duty_duration_seconds histogram
+ssv_duty_duration_seconds_bucket{le="1"} 3
+ssv_duty_duration_seconds_bucket{le="+Inf"} 5
+ssv_duty_duration_seconds_sum 4.5
+ssv_duty_duration_seconds_count 5
+`
+
+func TestParseSelector(t *testing.T) {
+	selector, err := ParseSelector(`ssv_validator_roles_failed{role="ATTESTER", reason!~"timeout|late"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "ssv_validator_roles_failed", selector.name)
+	require.Len(t, selector.matchers, 2)
+	assert.Equal(t, "!~", selector.matchers[1].operator)
+	assert.False(t, selector.matchers[1].matches("late"))
+	assert.True(t, selector.matchers[1].matches("latency"))
+
+	for _, invalid := range []string{"", "{}", `metric{role="a"`, `metric{role=a}`, `metric{role~"a"}`, `metric{role="a" reason="b"}`} {
+		_, err := ParseSelector(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestScrapeMetric_Measure(t *testing.T) {
+	submitted, failed := 100, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/metrics", r.URL.Path)
+		_, _ = fmt.Fprintf(w, scrapeResponse, submitted, failed)
+	}))
+	defer server.Close()
+
+	var measurements []ScrapeMeasurement
+	for _, m := range []struct{ name, measurementType, selector, total string }{
+		{"DutySuccessRatio", "ratio", `ssv_validator_roles_submitted{role="ATTESTER"}`, `{__name__=~"ssv_validator_roles_(submitted|failed)",role="ATTESTER"}`},
+		{"Failures", "increase", "ssv_validator_roles_failed", ""},
+		{"ActiveValidators", "value", "ssv_validators_active", ""},
+		{"Duties", "value", "ssv_duty_duration_seconds_count", ""},
+		{"Missing", "value", "ssv_unknown", ""},
+	} {
+		measurement, err := NewScrapeMeasurement(m.name, m.measurementType, m.selector, m.total)
+		require.NoError(t, err)
+		measurements = append(measurements, measurement)
+	}
+
+	metric := NewScrapeMetric("http://127.0.0.1:16000", server.URL, "Scrape", time.Second, measurements, nil)
+	metric.measure(context.Background())
+
+	require.Len(t, metric.DataPoints, 1)
+	assert.Equal(t, map[string]float64{"ActiveValidators": 4, "Duties": 5}, metric.DataPoints[0].Values)
+
+	submitted, failed = 190, 10
+	metric.measure(context.Background())
+
+	require.Len(t, metric.DataPoints, 2)
+	values := metric.DataPoints[1].Values
+	assert.Equal(t, 90.0, values["DutySuccessRatio"])
+	assert.Equal(t, 10.0, values["Failures"])
+	assert.NotContains(t, values, "Missing")
+	assert.Contains(t, metric.AggregateResults(), "not_found=[Missing]")
+
+	// a counter reset is reported as the increase from zero
+	submitted, failed = 5, 5
+	metric.measure(context.Background())
+	assert.Equal(t, 5.0, metric.DataPoints[2].Values["Failures"])
+	assert.Equal(t, 50.0, metric.DataPoints[2].Values["DutySuccessRatio"])
+
+	_, err := NewScrapeMeasurement("Ratio", "ratio", "metric", "")
+	assert.Error(t, err)
+	_, err = NewScrapeMeasurement("Rate", "rate", "metric", "")
+	assert.Error(t, err)
+}
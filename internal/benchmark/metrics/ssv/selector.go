@@ -0,0 +1,206 @@
+package ssv
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+const metricNameLabel = "__name__"
+
+type (
+	labelMatcher struct {
+		label    string
+		operator string
+		value    string
+		regex    *regexp.Regexp
+	}
+
+	// Selector selects series of the scraped metrics using the PromQL instant vector selector syntax, e.g.
+	// 'ssv_validator_roles_failed{role="ATTESTER"}' or '{__name__=~"ssv_validator_roles_(submitted|failed)"}'
+	Selector struct {
+		raw      string
+		name     string
+		matchers []labelMatcher
+	}
+)
+
+func (m labelMatcher) matches(value string) bool {
+	switch m.operator {
+	case "=":
+		return value == m.value
+	case "!=":
+		return value != m.value
+	case "=~":
+		return m.regex.MatchString(value)
+	case "!~":
+		return !m.regex.MatchString(value)
+	}
+	return false
+}
+
+func ParseSelector(str string) (Selector, error) {
+	selector := Selector{raw: strings.TrimSpace(str)}
+
+	rest := selector.raw
+	if i := strings.Index(rest, "{"); i >= 0 {
+		selector.name = strings.TrimSpace(rest[:i])
+		if !strings.HasSuffix(rest, "}") {
+			return Selector{}, fmt.Errorf("selector: '%s' was missing the closing brace", str)
+		}
+		matchers, err := parseMatchers(rest[i+1 : len(rest)-1])
+		if err != nil {
+			return Selector{}, fmt.Errorf("selector: '%s' was not valid. %w", str, err)
+		}
+		selector.matchers = matchers
+	} else {
+		selector.name = rest
+	}
+
+	if selector.name == "" && len(selector.matchers) == 0 {
+		return Selector{}, fmt.Errorf("selector: '%s' had neither a metric name nor label matchers", str)
+	}
+
+	return selector, nil
+}
+
+func parseMatchers(str string) ([]labelMatcher, error) {
+	var matchers []labelMatcher
+	for rest := strings.TrimSpace(str); rest != ""; {
+		i := strings.IndexAny(rest, "=!")
+		if i <= 0 {
+			return nil, fmt.Errorf("matcher: '%s' was missing a label name or an operator", rest)
+		}
+		matcher := labelMatcher{label: strings.TrimSpace(rest[:i])}
+		rest = rest[i:]
+
+		for _, operator := range []string{"=~", "!~", "!=", "="} {
+			if strings.HasPrefix(rest, operator) {
+				matcher.operator = operator
+				break
+			}
+		}
+		if matcher.operator == "" {
+			return nil, fmt.Errorf("matcher of label: '%s' had an unsupported operator", matcher.label)
+		}
+		rest = strings.TrimSpace(rest[len(matcher.operator):])
+
+		quoted, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return nil, fmt.Errorf("value of label: '%s' was not a quoted string", matcher.label)
+		}
+		if matcher.value, err = strconv.Unquote(quoted); err != nil {
+			return nil, err
+		}
+		rest = strings.TrimSpace(rest[len(quoted):])
+
+		if matcher.operator == "=~" || matcher.operator == "!~" {
+			// regular expressions are fully anchored as in PromQL
+			if matcher.regex, err = regexp.Compile("^(?:" + matcher.value + ")$"); err != nil {
+				return nil, err
+			}
+		}
+		matchers = append(matchers, matcher)
+
+		if rest != "" {
+			if !strings.HasPrefix(rest, ",") {
+				return nil, fmt.Errorf("matchers were not separated by a comma: '%s'", rest)
+			}
+			rest = strings.TrimSpace(rest[1:])
+		}
+	}
+
+	return matchers, nil
+}
+
+func (s Selector) String() string {
+	return s.raw
+}
+
+// Sum adds up the values of all series matching the selector. The '_sum' and '_count' series of summaries and
+// histograms are selected by their suffixed name. It returns false when no series matched.
+func (s Selector) Sum(families map[string]*dto.MetricFamily) (float64, bool) {
+	var (
+		total float64
+		found bool
+	)
+	for name, family := range families {
+		for _, suffix := range []string{"", "_sum", "_count"} {
+			if !s.matchesName(name + suffix) {
+				continue
+			}
+			for _, m := range family.GetMetric() {
+				if !s.matchesLabels(m.GetLabel()) {
+					continue
+				}
+				if value, ok := sampleValue(family.GetType(), suffix, m); ok {
+					total += value
+					found = true
+				}
+			}
+		}
+	}
+
+	return total, found
+}
+
+func (s Selector) matchesName(name string) bool {
+	if s.name != "" && s.name != name {
+		return false
+	}
+	for _, matcher := range s.matchers {
+		if matcher.label == metricNameLabel && !matcher.matches(name) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s Selector) matchesLabels(labels []*dto.LabelPair) bool {
+	for _, matcher := range s.matchers {
+		if matcher.label == metricNameLabel {
+			continue
+		}
+		// a missing label matches as an empty value as in PromQL
+		var value string
+		for _, label := range labels {
+			if label.GetName() == matcher.label {
+				value = label.GetValue()
+				break
+			}
+		}
+		if !matcher.matches(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func sampleValue(metricType dto.MetricType, suffix string, m *dto.Metric) (float64, bool) {
+	switch metricType {
+	case dto.MetricType_COUNTER:
+		return m.GetCounter().GetValue(), suffix == ""
+	case dto.MetricType_GAUGE:
+		return m.GetGauge().GetValue(), suffix == ""
+	case dto.MetricType_UNTYPED:
+		return m.GetUntyped().GetValue(), suffix == ""
+	case dto.MetricType_SUMMARY:
+		switch suffix {
+		case "_sum":
+			return m.GetSummary().GetSampleSum(), true
+		case "_count":
+			return float64(m.GetSummary().GetSampleCount()), true
+		}
+	case dto.MetricType_HISTOGRAM:
+		switch suffix {
+		case "_sum":
+			return m.GetHistogram().GetSampleSum(), true
+		case "_count":
+			return float64(m.GetHistogram().GetSampleCount()), true
+		}
+	}
+	return 0, false
+}
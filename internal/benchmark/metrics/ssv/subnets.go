@@ -0,0 +1,191 @@
+package ssv
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/logger"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/metric"
+)
+
+const (
+	SubscribedSubnetsMeasurement = "SubscribedSubnets"
+	SubnetCoverageMeasurement    = "SubnetCoverage"
+	LowPeerSubnetsMeasurement    = "LowPeerSubnets"
+)
+
+// topicsResponse is the response of '/v1/node/topics'. Topics are named after their subnet, e.g. 'ssv.v2.42'.
+type topicsResponse struct {
+	PeersByTopic []struct {
+		Topic string   `json:"topic"`
+		Peers []string `json:"peers"`
+	} `json:"peers_by_topic"`
+}
+
+// parseSubnets returns the subnets set in the hex encoded bitmask. The SSV node encodes the subnets as a bitvector, which is
+// least significant bit first within each byte, so bit j of byte i is subnet i*8+j.
+func parseSubnets(bitmask string) ([]int, error) {
+	bytes, err := hex.DecodeString(strings.TrimPrefix(bitmask, "0x"))
+	if err != nil {
+		return nil, errors.Join(err, fmt.Errorf("subnets: '%s' was not a valid hex string", bitmask))
+	}
+
+	var subnets []int
+	for i, value := range bytes {
+		for bit := range 8 {
+			if value&(1<<bit) != 0 {
+				subnets = append(subnets, i*8+bit)
+			}
+		}
+	}
+	return subnets, nil
+}
+
+// topicSubnet returns the subnet of the topic name, e.g. 42 for 'ssv.v2.42'
+func topicSubnet(topic string) (int, bool) {
+	i := strings.LastIndex(topic, ".")
+	if i < 0 {
+		return 0, false
+	}
+	subnet, err := strconv.Atoi(topic[i+1:])
+	if err != nil {
+		return 0, false
+	}
+	return subnet, true
+}
+
+// SubnetMetric reports how well the subnets the SSV node is subscribed to are covered by peers. Subnets with fewer than
+// the minimum number of peers slow down QBFT rounds, which the aggregate peer count does not reveal.
+type SubnetMetric struct {
+	metric.Base[float64]
+	url            string
+	interval       time.Duration
+	minPeers       int
+	lowPeerSubnets []int
+	subnetPeers    map[int]int
+}
+
+func NewSubnetMetric(url, name string, interval time.Duration, minPeers int, healthCondition []metric.HealthCondition[float64]) *SubnetMetric {
+	return &SubnetMetric{
+		url: url,
+		Base: metric.Base[float64]{
+			HealthConditions: healthCondition,
+			Name:             name,
+		},
+		interval: interval,
+		minPeers: minPeers,
+	}
+}
+
+func (s *SubnetMetric) Measure(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.With("metric_name", s.Name).Debug("metric was stopped")
+			return
+		case <-ticker.C:
+			s.measure(ctx)
+		}
+	}
+}
+
+func (s *SubnetMetric) measure(ctx context.Context) {
+	var (
+		identity identityResponse
+		topics   topicsResponse
+	)
+	if err := fetch(ctx, fmt.Sprintf("%s/v1/node/identity", s.url), &identity); err != nil {
+		logger.WriteError(metric.SSVGroup, s.Name, errors.Join(err, errors.New("failed fetching node identity")))
+		return
+	}
+	if err := fetch(ctx, fmt.Sprintf("%s/v1/node/topics", s.url), &topics); err != nil {
+		logger.WriteError(metric.SSVGroup, s.Name, errors.Join(err, errors.New("failed fetching node topics")))
+		return
+	}
+
+	subscribed, err := parseSubnets(identity.Subnets)
+	if err != nil {
+		logger.WriteError(metric.SSVGroup, s.Name, err)
+		return
+	}
+
+	subnetPeers := make(map[int]int)
+	for _, topic := range topics.PeersByTopic {
+		if subnet, ok := topicSubnet(topic.Topic); ok {
+			subnetPeers[subnet] = len(topic.Peers)
+		}
+	}
+
+	var lowPeerSubnets []int
+	for _, subnet := range subscribed {
+		if subnetPeers[subnet] < s.minPeers {
+			lowPeerSubnets = append(lowPeerSubnets, subnet)
+		}
+	}
+
+	s.writeMetric(subscribed, subnetPeers, lowPeerSubnets)
+}
+
+func (s *SubnetMetric) writeMetric(subscribed []int, subnetPeers map[int]int, lowPeerSubnets []int) {
+	s.subnetPeers = subnetPeers
+	s.lowPeerSubnets = lowPeerSubnets
+
+	values := map[string]float64{
+		SubscribedSubnetsMeasurement: float64(len(subscribed)),
+		LowPeerSubnetsMeasurement:    float64(len(lowPeerSubnets)),
+	}
+	if len(subscribed) != 0 {
+		values[SubnetCoverageMeasurement] = float64(len(subscribed)-len(lowPeerSubnets)) / float64(len(subscribed)) * 100
+	}
+	s.AddDataPoint(values)
+
+	subscribedSubnetsMetric.With(serverAddrLabel(s.url)).Set(values[SubscribedSubnetsMeasurement])
+	for _, subnet := range subscribed {
+		subnetPeersMetric.With(subnetLabels(s.url, subnet)).Set(float64(subnetPeers[subnet]))
+	}
+
+	var low []string
+	for _, subnet := range lowPeerSubnets {
+		low = append(low, strconv.Itoa(subnet))
+	}
+	logger.WriteMetric(metric.SSVGroup, s.Name, map[string]any{
+		SubscribedSubnetsMeasurement: values[SubscribedSubnetsMeasurement],
+		SubnetCoverageMeasurement:    values[SubnetCoverageMeasurement],
+		LowPeerSubnetsMeasurement:    values[LowPeerSubnetsMeasurement],
+	}, map[string]any{
+		"low_peer_subnets": strings.Join(low, ","),
+	})
+}
+
+func (s *SubnetMetric) AggregateResults() string {
+	if len(s.DataPoints) == 0 {
+		return ""
+	}
+
+	var coverages []float64
+	for _, point := range s.DataPoints {
+		coverages = append(coverages, point.Values[SubnetCoverageMeasurement])
+	}
+	percentiles := metric.CalculatePercentiles(coverages, 0, 50)
+
+	var low []string
+	for _, subnet := range slices.Sorted(slices.Values(s.lowPeerSubnets)) {
+		low = append(low, fmt.Sprintf("%d: %d", subnet, s.subnetPeers[subnet]))
+	}
+
+	return fmt.Sprintf("subscribed=%.0f, coverage_min=%.2f%%, coverage_P50=%.2f%% \n low_peer_subnets=[%s]",
+		s.DataPoints[len(s.DataPoints)-1].Values[SubscribedSubnetsMeasurement],
+		percentiles[0],
+		percentiles[50],
+		strings.Join(low, ", "))
+}
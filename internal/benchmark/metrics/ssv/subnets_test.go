@@ -0,0 +1,72 @@
+package ssv
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/version"
+)
+
+func TestParseSubnets(t *testing.T) {
+	// identity subnets of a node subscribed to the subnets 3, 8, 42 and 127
+	subnets, err := parseSubnets("08010000000400000000000000000080")
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 8, 42, 127}, subnets)
+
+	subnets, err = parseSubnets("0x05")
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 2}, subnets)
+
+	_, err = parseSubnets("xyz")
+	assert.Error(t, err)
+}
+
+func newIdentityServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/node/identity":
+			_, _ = w.Write([]byte(`{"peer_id":"16Uiu2HAm","subnets":"07000000000000000000000000000000","version":"v1.3.8","network":"holesky"}`))
+		case "/v1/node/topics":
+			_, _ = w.Write([]byte(`{"all_peers":["a","b","c","d"],"peers_by_topic":[{"topic":"ssv.v2.0","peers":["a","b","c"]},{"topic":"ssv.v2.1","peers":["a"]},{"topic":"ssv.v2.5","peers":[]}]}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestSubnetMetric_Measure(t *testing.T) {
+	server := newIdentityServer(t)
+	defer server.Close()
+
+	metric := NewSubnetMetric(server.URL, "Subnets", time.Second, 3, nil)
+	metric.measure(context.Background())
+
+	require.Len(t, metric.DataPoints, 1)
+	values := metric.DataPoints[0].Values
+	assert.Equal(t, 3.0, values[SubscribedSubnetsMeasurement])
+	assert.Equal(t, 2.0, values[LowPeerSubnetsMeasurement])
+	assert.InDelta(t, 33.33, values[SubnetCoverageMeasurement], 0.01)
+	assert.Contains(t, metric.AggregateResults(), "low_peer_subnets=[1: 1, 2: 0]")
+}
+
+func TestClientMetric_Measure(t *testing.T) {
+	server := newIdentityServer(t)
+	defer server.Close()
+
+	policy := version.Policy{Rules: []version.Rule{{Client: version.SSV, Minimum: "v2.0.0"}}}
+	metric := NewClientMetric(server.URL, "Client", time.Minute, network.Name("holesky"), policy, nil)
+	metric.measure(context.Background())
+
+	require.Len(t, metric.DataPoints, 1)
+	assert.Equal(t, "v1.3.8", metric.DataPoints[0].Values[VersionMeasurement])
+	assert.Equal(t, string(version.StatusOutdated), metric.DataPoints[0].Values[VersionPolicyMeasurement])
+	assert.Contains(t, metric.AggregateResults(), "peer_id=16Uiu2HAm")
+}
@@ -0,0 +1,74 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/ssvlabs/ssv-pulse/configs"
+	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/consensus"
+	"github.com/ssvlabs/ssv-pulse/internal/platform/network"
+)
+
+// LoadNetwork resolves the parameters of the configured network. Parameters served by the consensus client take precedence
+// over the statically configured ones, as long as both describe the same network.
+func LoadNetwork(ctx context.Context, config configs.Benchmark) (network.Network, error) {
+	configured, err := config.NetworkParams()
+	if err != nil {
+		return network.Network{}, err
+	}
+
+	for _, addr := range config.Consensus.Addresses {
+		fetched, err := consensus.FetchNetwork(ctx, addr)
+		if err != nil {
+			slog.
+				With("addr", addr).
+				With("err", err.Error()).
+				Warn("failed fetching network parameters from Consensus client, trying next one")
+			continue
+		}
+
+		if err := checkNetworkConsistency(configured, fetched); err != nil {
+			return network.Network{}, errors.Join(err, fmt.Errorf("consensus client: '%s' is not on the configured network", addr))
+		}
+
+		fetched.Name = configured.Name
+		fetched.ChainID = configured.ChainID
+		fetched.DepositContract = configured.DepositContract
+		if err := fetched.Validate(); err != nil {
+			return network.Network{}, errors.Join(err, fmt.Errorf("network parameters fetched from Consensus client: '%s' were not valid", addr))
+		}
+
+		slog.
+			With("addr", addr).
+			With("network", fetched).
+			Info("network parameters fetched from Consensus client")
+		return fetched, nil
+	}
+
+	if err := configured.Validate(); err != nil {
+		return network.Network{}, errors.Join(err, errors.New("network parameters were incomplete and could not be fetched from Consensus client"))
+	}
+
+	return configured, nil
+}
+
+func checkNetworkConsistency(configured, fetched network.Network) error {
+	var consistencyErr error
+
+	if _, isSupported := network.Supported[configured.Name]; isSupported && fetched.Name != "" && fetched.Name != configured.Name {
+		consistencyErr = errors.Join(consistencyErr, fmt.Errorf("network name mismatch. Configured: '%s', Consensus client: '%s'", configured.Name, fetched.Name))
+	}
+	if !configured.GenesisTime.IsZero() && !configured.GenesisTime.Equal(fetched.GenesisTime) {
+		consistencyErr = errors.Join(consistencyErr, fmt.Errorf("genesis time mismatch. Configured: '%d', Consensus client: '%d'", configured.GenesisTime.Unix(), fetched.GenesisTime.Unix()))
+	}
+	if configured.SecondsPerSlot != 0 && configured.SecondsPerSlot != fetched.SecondsPerSlot {
+		consistencyErr = errors.Join(consistencyErr, fmt.Errorf("seconds per slot mismatch. Configured: '%d', Consensus client: '%d'", configured.SecondsPerSlot, fetched.SecondsPerSlot))
+	}
+	if configured.SlotsPerEpoch != 0 && configured.SlotsPerEpoch != fetched.SlotsPerEpoch {
+		consistencyErr = errors.Join(consistencyErr, fmt.Errorf("slots per epoch mismatch. Configured: '%d', Consensus client: '%d'", configured.SlotsPerEpoch, fetched.SlotsPerEpoch))
+	}
+
+	return consistencyErr
+}
@@ -0,0 +1,36 @@
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	"github.com/ssvlabs/ssv-pulse/configs"
+	"github.com/ssvlabs/ssv-pulse/internal/benchmark/metrics/consensus"
+)
+
+// LoadValidators returns indices of the configured validators, resolving public keys through the Consensus clients
+func LoadValidators(ctx context.Context, config configs.Benchmark) ([]phase0.ValidatorIndex, error) {
+	indices, pubKeys, err := config.Consensus.ValidatorIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	var validators []phase0.ValidatorIndex
+	for _, index := range indices {
+		validators = append(validators, phase0.ValidatorIndex(index))
+	}
+
+	if len(pubKeys) != 0 {
+		resolved, err := consensus.FetchValidatorIndices(ctx, config.Consensus.Addresses, pubKeys)
+		if err != nil {
+			return nil, errors.Join(err, errors.New("failed resolving validator public keys to indices"))
+		}
+		validators = append(validators, resolved...)
+	}
+
+	slices.Sort(validators)
+	return slices.Compact(validators), nil
+}
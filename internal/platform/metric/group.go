@@ -7,4 +7,5 @@ const (
 	ExecutionGroup      Group = "Execution"
 	SSVGroup            Group = "SSV"
 	InfrastructureGroup Group = "Infrastructure"
+	ValidatorGroup      Group = "Validator"
 )
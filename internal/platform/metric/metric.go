@@ -15,8 +15,12 @@ type (
 		Name             string
 		DataPoints       []DataPoint[T]
 		HealthConditions []HealthCondition[T]
+		severityHook     SeverityHook
 	}
 
+	// SeverityHook is called with the highest severity of every data point that triggers a health condition
+	SeverityHook func(metricName string, severity SeverityLevel)
+
 	DataPoint[T Metricable] struct {
 		Timestamp time.Time
 		Values    map[string]T
@@ -27,11 +31,31 @@ func (bm *Base[T]) GetName() string {
 	return bm.Name
 }
 
+// OnSeverity registers a hook called while measuring, it must be registered before the metric is measured
+func (bm *Base[T]) OnSeverity(hook SeverityHook) {
+	bm.severityHook = hook
+}
+
 func (bm *Base[T]) AddDataPoint(values map[string]T) {
 	bm.DataPoints = append(bm.DataPoints, DataPoint[T]{
 		Timestamp: time.Now(),
 		Values:    values,
 	})
+
+	if bm.severityHook == nil {
+		return
+	}
+	severity := SeverityNone
+	for name, value := range values {
+		for _, condition := range bm.HealthConditions {
+			if condition.Name == name && condition.Evaluate(value) && CompareSeverities(condition.Severity, severity) > 0 {
+				severity = condition.Severity
+			}
+		}
+	}
+	if severity != SeverityNone {
+		bm.severityHook(bm.Name, severity)
+	}
 }
 
 func (bm *Base[T]) EvaluateMetric() (HealthStatus, map[string]SeverityLevel) {
@@ -0,0 +1,29 @@
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBase_OnSeverity(t *testing.T) {
+	base := Base[int]{
+		Name: "Peers",
+		HealthConditions: []HealthCondition[int]{
+			{Name: "Count", Threshold: 5, Operator: OperatorLessThanOrEqual, Severity: SeverityHigh},
+			{Name: "Count", Threshold: 10, Operator: OperatorLessThanOrEqual, Severity: SeverityMedium},
+		},
+	}
+
+	var severities []SeverityLevel
+	base.OnSeverity(func(metricName string, severity SeverityLevel) {
+		assert.Equal(t, "Peers", metricName)
+		severities = append(severities, severity)
+	})
+
+	base.AddDataPoint(map[string]int{"Count": 20})
+	base.AddDataPoint(map[string]int{"Count": 8})
+	base.AddDataPoint(map[string]int{"Count": 3})
+
+	assert.Equal(t, []SeverityLevel{SeverityMedium, SeverityHigh}, severities)
+}
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"errors"
 	"fmt"
 	"maps"
 	"slices"
@@ -9,10 +10,23 @@ import (
 )
 
 type (
-	Name    string
+	Name string
+	Fork struct {
+		Name    string
+		Epoch   uint64
+		Version string
+	}
 	Network struct {
-		Name        Name
-		GenesisTime time.Time
+		Name Name
+		// ChainID is the execution layer chain ID, which is also reported by the deposit contract endpoint of the consensus client
+		ChainID         uint64
+		DepositContract string
+		// SSVNetworkContract is the address of the SSV network contract, empty if SSV is not deployed on the network
+		SSVNetworkContract string
+		GenesisTime        time.Time
+		SecondsPerSlot     uint64
+		SlotsPerEpoch      uint64
+		Forks              []Fork
 	}
 )
 
@@ -21,14 +35,84 @@ const (
 	Mainnet Name = "mainnet"
 	Hoodi   Name = "hoodi"
 	Sepolia Name = "sepolia"
+
+	DefaultSecondsPerSlot = 12
+	DefaultSlotsPerEpoch  = 32
 )
 
 var (
 	Supported = map[Name]Network{
-		Holesky: {Name: Holesky, GenesisTime: time.Unix(1695902400, 0)},
-		Mainnet: {Name: Mainnet, GenesisTime: time.Unix(1606824023, 0)},
-		Hoodi:   {Name: Hoodi, GenesisTime: time.Unix(1742213400, 0)},
-		Sepolia: {Name: Sepolia, GenesisTime: time.Unix(1655733600, 0)},
+		Holesky: {
+			Name:               Holesky,
+			ChainID:            17000,
+			DepositContract:    "0x4242424242424242424242424242424242424242",
+			SSVNetworkContract: "0x38A4794cCEd47d3baf7370CcC43B560D3a1beEFA",
+			GenesisTime:        time.Unix(1695902400, 0),
+			SecondsPerSlot:     DefaultSecondsPerSlot,
+			SlotsPerEpoch:      DefaultSlotsPerEpoch,
+			Forks: []Fork{
+				{Name: "phase0", Epoch: 0, Version: "0x01017000"},
+				{Name: "altair", Epoch: 0, Version: "0x02017000"},
+				{Name: "bellatrix", Epoch: 0, Version: "0x03017000"},
+				{Name: "capella", Epoch: 256, Version: "0x04017000"},
+				{Name: "deneb", Epoch: 29696, Version: "0x05017000"},
+				{Name: "electra", Epoch: 115968, Version: "0x06017000"},
+				{Name: "fulu", Epoch: 165120, Version: "0x07017000"},
+			},
+		},
+		Mainnet: {
+			Name:               Mainnet,
+			ChainID:            1,
+			DepositContract:    "0x00000000219ab540356cBB839Cbe05303d7705Fa",
+			SSVNetworkContract: "0xDD9BC35aE942eF0cFa76930954a156B3fF30a4E1",
+			GenesisTime:        time.Unix(1606824023, 0),
+			SecondsPerSlot:     DefaultSecondsPerSlot,
+			SlotsPerEpoch:      DefaultSlotsPerEpoch,
+			Forks: []Fork{
+				{Name: "phase0", Epoch: 0, Version: "0x00000000"},
+				{Name: "altair", Epoch: 74240, Version: "0x01000000"},
+				{Name: "bellatrix", Epoch: 144896, Version: "0x02000000"},
+				{Name: "capella", Epoch: 194048, Version: "0x03000000"},
+				{Name: "deneb", Epoch: 269568, Version: "0x04000000"},
+				{Name: "electra", Epoch: 364032, Version: "0x05000000"},
+				{Name: "fulu", Epoch: 411392, Version: "0x06000000"},
+			},
+		},
+		Hoodi: {
+			Name:               Hoodi,
+			ChainID:            560048,
+			DepositContract:    "0x00000000219ab540356cBB839Cbe05303d7705Fa",
+			SSVNetworkContract: "0x58410Bef803ECd7E63B23664C586A6DB72DAf59c",
+			GenesisTime:        time.Unix(1742213400, 0),
+			SecondsPerSlot:     DefaultSecondsPerSlot,
+			SlotsPerEpoch:      DefaultSlotsPerEpoch,
+			Forks: []Fork{
+				{Name: "phase0", Epoch: 0, Version: "0x10000910"},
+				{Name: "altair", Epoch: 0, Version: "0x20000910"},
+				{Name: "bellatrix", Epoch: 0, Version: "0x30000910"},
+				{Name: "capella", Epoch: 0, Version: "0x40000910"},
+				{Name: "deneb", Epoch: 0, Version: "0x50000910"},
+				{Name: "electra", Epoch: 2048, Version: "0x60000910"},
+				{Name: "fulu", Epoch: 50688, Version: "0x70000910"},
+			},
+		},
+		Sepolia: {
+			Name:            Sepolia,
+			ChainID:         11155111,
+			DepositContract: "0x7f02C3E3c98b133055B8B348B2Ac625669Ed295D",
+			GenesisTime:     time.Unix(1655733600, 0),
+			SecondsPerSlot:  DefaultSecondsPerSlot,
+			SlotsPerEpoch:   DefaultSlotsPerEpoch,
+			Forks: []Fork{
+				{Name: "phase0", Epoch: 0, Version: "0x90000069"},
+				{Name: "altair", Epoch: 50, Version: "0x90000070"},
+				{Name: "bellatrix", Epoch: 100, Version: "0x90000071"},
+				{Name: "capella", Epoch: 56832, Version: "0x90000072"},
+				{Name: "deneb", Epoch: 132608, Version: "0x90000073"},
+				{Name: "electra", Epoch: 222464, Version: "0x90000074"},
+				{Name: "fulu", Epoch: 272640, Version: "0x90000075"},
+			},
+		},
 	}
 )
 
@@ -40,3 +124,40 @@ func (n Name) Validate() error {
 
 	return nil
 }
+
+// Validate checks that the network parameters are usable for slot calculations
+func (n Network) Validate() error {
+	var validationErr error
+	if n.Name == "" {
+		validationErr = errors.Join(validationErr, errors.New("network name was empty"))
+	}
+	if n.GenesisTime.IsZero() {
+		validationErr = errors.Join(validationErr, errors.New("genesis time was empty"))
+	}
+	if n.SecondsPerSlot == 0 {
+		validationErr = errors.Join(validationErr, errors.New("seconds per slot was empty"))
+	}
+	if n.SlotsPerEpoch == 0 {
+		validationErr = errors.Join(validationErr, errors.New("slots per epoch was empty"))
+	}
+
+	return validationErr
+}
+
+// ForkEpoch returns the activation epoch of the fork, false when the fork is not scheduled
+func (n Network) ForkEpoch(name string) (uint64, bool) {
+	for _, fork := range n.Forks {
+		if fork.Name == name {
+			return fork.Epoch, true
+		}
+	}
+	return 0, false
+}
+
+func (n Network) SlotDuration() time.Duration {
+	return time.Duration(n.SecondsPerSlot) * time.Second
+}
+
+func (n Network) EpochDuration() time.Duration {
+	return time.Duration(n.SlotsPerEpoch) * n.SlotDuration()
+}
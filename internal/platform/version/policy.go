@@ -0,0 +1,109 @@
+package version
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+type (
+	Status string
+
+	Rule struct {
+		Client Client `mapstructure:"client"`
+		// Network the rule applies to. Applies to all networks when empty
+		Network string   `mapstructure:"network"`
+		Minimum string   `mapstructure:"minimum"`
+		Blocked []string `mapstructure:"blocked"`
+	}
+
+	Policy struct {
+		Rules []Rule `mapstructure:"rules"`
+	}
+)
+
+const (
+	StatusCompliant Status = "Compliant"
+	StatusOutdated  Status = "Outdated"
+	StatusBlocked   Status = "Blocked"
+	StatusUnknown   Status = "Unknown"
+)
+
+// LoadPolicy reads the version policy file, e.g.
+//
+//	rules:
+//	  - client: lighthouse
+//	    network: mainnet
+//	    minimum: v5.3.0
+//	    blocked: [v5.2.0]
+func LoadPolicy(path string) (Policy, error) {
+	var policy Policy
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return Policy{}, errors.Join(err, fmt.Errorf("failed reading version policy file: '%s'", path))
+	}
+	if err := v.Unmarshal(&policy); err != nil {
+		return Policy{}, errors.Join(err, fmt.Errorf("failed decoding version policy file: '%s'", path))
+	}
+
+	if err := policy.Validate(); err != nil {
+		return Policy{}, err
+	}
+
+	return policy, nil
+}
+
+func (p Policy) Validate() error {
+	var validationErr error
+	for i, rule := range p.Rules {
+		if !Client(strings.ToLower(string(rule.Client))).IsKnown() {
+			validationErr = errors.Join(validationErr, fmt.Errorf("rule %d: unknown client: '%s'", i, rule.Client))
+		}
+		if rule.Minimum != "" {
+			if _, err := ParseSemver(rule.Minimum); err != nil {
+				validationErr = errors.Join(validationErr, fmt.Errorf("rule %d: %w", i, err))
+			}
+		}
+		for _, blocked := range rule.Blocked {
+			if _, err := ParseSemver(blocked); err != nil {
+				validationErr = errors.Join(validationErr, fmt.Errorf("rule %d: %w", i, err))
+			}
+		}
+	}
+	return validationErr
+}
+
+// Check evaluates the client version against all the rules matching the client and the network.
+// Blocked versions take precedence over outdated ones.
+func (p Policy) Check(info Info, network string) Status {
+	status := StatusCompliant
+
+	for _, rule := range p.Rules {
+		if Client(strings.ToLower(string(rule.Client))) != info.Client {
+			continue
+		}
+		if rule.Network != "" && !strings.EqualFold(rule.Network, network) {
+			continue
+		}
+
+		for _, blocked := range rule.Blocked {
+			blockedVersion, err := ParseSemver(blocked)
+			if err == nil && blockedVersion.Compare(info.Version) == 0 {
+				return StatusBlocked
+			}
+		}
+
+		if rule.Minimum != "" {
+			minimum, err := ParseSemver(rule.Minimum)
+			if err == nil && info.Version.Compare(minimum) < 0 {
+				status = StatusOutdated
+			}
+		}
+	}
+
+	return status
+}
@@ -0,0 +1,158 @@
+package version
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type (
+	Client string
+
+	Semver struct {
+		Major, Minor, Patch uint64
+		// Suffix holds everything after the patch number, e.g. pre-release tags or commit hashes
+		Suffix string
+	}
+
+	Info struct {
+		Raw     string
+		Client  Client
+		Version Semver
+	}
+)
+
+const (
+	Lighthouse Client = "lighthouse"
+	Prysm      Client = "prysm"
+	Teku       Client = "teku"
+	Nimbus     Client = "nimbus"
+	Lodestar   Client = "lodestar"
+	Grandine   Client = "grandine"
+
+	Geth       Client = "geth"
+	Nethermind Client = "nethermind"
+	Besu       Client = "besu"
+	Erigon     Client = "erigon"
+	Reth       Client = "reth"
+
+	SSV Client = "ssv"
+)
+
+var (
+	ConsensusClients = []Client{Lighthouse, Prysm, Teku, Nimbus, Lodestar, Grandine}
+	ExecutionClients = []Client{Geth, Nethermind, Besu, Erigon, Reth}
+
+	preReleaseTags = []string{"alpha", "beta", "rc", "unstable"}
+)
+
+// Parse parses version strings reported by the '/eth/v1/node/version' and 'web3_clientVersion' endpoints,
+// e.g. 'Lighthouse/v5.3.0-d6ba8c3/x86_64-linux' or 'Geth/v1.14.11-stable-f3c696fa/linux-amd64/go1.23.2'.
+func Parse(raw string) (Info, error) {
+	segments := strings.Split(strings.TrimSpace(raw), "/")
+	if len(segments) < 2 {
+		return Info{}, fmt.Errorf("unexpected version format: '%s'", raw)
+	}
+
+	client := Client(strings.ToLower(segments[0]))
+	if !client.IsKnown() {
+		return Info{}, fmt.Errorf("unknown client: '%s'", segments[0])
+	}
+
+	// Prysm appends the platform after a space, e.g. 'Prysm/v5.1.0 (linux amd64)'
+	versionSegment, _, _ := strings.Cut(segments[1], " ")
+	semver, err := ParseSemver(versionSegment)
+	if err != nil {
+		return Info{}, errors.Join(err, fmt.Errorf("failed parsing version of client: '%s'", client))
+	}
+
+	return Info{
+		Raw:     raw,
+		Client:  client,
+		Version: semver,
+	}, nil
+}
+
+// ParseSemver parses 'v1.2.3', '1.2.3-rc.1', '1.2.3+abc' and the shorter '1.2' or '1' forms
+func ParseSemver(str string) (Semver, error) {
+	str = strings.TrimPrefix(strings.TrimSpace(str), "v")
+
+	core, suffix := str, ""
+	if i := strings.IndexAny(str, "-+"); i >= 0 {
+		core, suffix = str[:i], str[i+1:]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 || core == "" {
+		return Semver{}, fmt.Errorf("invalid semantic version: '%s'", str)
+	}
+
+	var numbers [3]uint64
+	for i, part := range parts {
+		number, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return Semver{}, errors.Join(err, fmt.Errorf("invalid semantic version: '%s'", str))
+		}
+		numbers[i] = number
+	}
+
+	return Semver{
+		Major:  numbers[0],
+		Minor:  numbers[1],
+		Patch:  numbers[2],
+		Suffix: suffix,
+	}, nil
+}
+
+func (c Client) IsKnown() bool {
+	return c.IsConsensus() || c.IsExecution() || c == SSV
+}
+
+func (c Client) IsConsensus() bool {
+	return slices.Contains(ConsensusClients, c)
+}
+
+func (c Client) IsExecution() bool {
+	return slices.Contains(ExecutionClients, c)
+}
+
+// IsPreRelease reports whether the suffix marks an alpha, beta, release candidate or unstable build
+func (s Semver) IsPreRelease() bool {
+	suffix := strings.ToLower(s.Suffix)
+	for _, tag := range preReleaseTags {
+		if strings.HasPrefix(suffix, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare returns -1, 0 or +1 depending on whether s is lower, equal or greater than other.
+// A pre-release is lower than the release of the same version, any other suffix is ignored.
+func (s Semver) Compare(other Semver) int {
+	if c := cmp.Compare(s.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(s.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(s.Patch, other.Patch); c != 0 {
+		return c
+	}
+
+	switch {
+	case s.IsPreRelease() && !other.IsPreRelease():
+		return -1
+	case !s.IsPreRelease() && other.IsPreRelease():
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (s Semver) String() string {
+	return fmt.Sprintf("%d.%d.%d", s.Major, s.Minor, s.Patch)
+}
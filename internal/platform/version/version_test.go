@@ -0,0 +1,121 @@
+package version
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		raw     string
+		client  Client
+		version Semver
+	}{
+		{raw: "Lighthouse/v5.3.0-d6ba8c3/x86_64-linux", client: Lighthouse, version: Semver{Major: 5, Minor: 3, Patch: 0, Suffix: "d6ba8c3"}},
+		{raw: "Prysm/v5.1.0 (linux amd64)", client: Prysm, version: Semver{Major: 5, Minor: 1, Patch: 0}},
+		{raw: "teku/v24.10.0/linux-x86_64/-eclipseadoptium-openjdk64bitservervm-java-21", client: Teku, version: Semver{Major: 24, Minor: 10, Patch: 0}},
+		{raw: "Nimbus/v24.10.0-aa0ff0-stateofus", client: Nimbus, version: Semver{Major: 24, Minor: 10, Patch: 0, Suffix: "aa0ff0-stateofus"}},
+		{raw: "Lodestar/v1.22.0/b5be6ba", client: Lodestar, version: Semver{Major: 1, Minor: 22, Patch: 0}},
+		{raw: "Grandine/1.0.0-6b2ff1f/x86_64-linux", client: Grandine, version: Semver{Major: 1, Minor: 0, Patch: 0, Suffix: "6b2ff1f"}},
+		{raw: "Geth/v1.14.11-stable-f3c696fa/linux-amd64/go1.23.2", client: Geth, version: Semver{Major: 1, Minor: 14, Patch: 11, Suffix: "stable-f3c696fa"}},
+		{raw: "Nethermind/v1.29.0+20c5b5c1/linux-x64/dotnet8.0.10", client: Nethermind, version: Semver{Major: 1, Minor: 29, Patch: 0, Suffix: "20c5b5c1"}},
+		{raw: "besu/v24.10.0/linux-x86_64/openjdk-java-21", client: Besu, version: Semver{Major: 24, Minor: 10, Patch: 0}},
+		{raw: "erigon/2.60.10/linux-amd64/go1.22.8", client: Erigon, version: Semver{Major: 2, Minor: 60, Patch: 10}},
+		{raw: "reth/v1.1.0-1ba631b/x86_64-unknown-linux-gnu", client: Reth, version: Semver{Major: 1, Minor: 1, Patch: 0, Suffix: "1ba631b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			info, err := Parse(tt.raw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.client, info.Client)
+			assert.Equal(t, tt.version, info.Version)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, raw := range []string{"", "Lighthouse", "unknown/v1.0.0", "Lighthouse/latest"} {
+		t.Run(raw, func(t *testing.T) {
+			_, err := Parse(raw)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSemver_Compare(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "1.2.3", b: "1.2.3", expected: 0},
+		{a: "1.2.3", b: "1.2.4", expected: -1},
+		{a: "1.3.0", b: "1.2.9", expected: 1},
+		{a: "2.0", b: "1.99.99", expected: 1},
+		{a: "1.2.3-rc.1", b: "1.2.3", expected: -1},
+		{a: "1.2.3-d6ba8c3", b: "1.2.3", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			a, err := ParseSemver(tt.a)
+			require.NoError(t, err)
+			b, err := ParseSemver(tt.b)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, a.Compare(b))
+		})
+	}
+}
+
+func TestPolicy_Check(t *testing.T) {
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte(`
+rules:
+  - client: lighthouse
+    minimum: v5.3.0
+  - client: lighthouse
+    network: holesky
+    blocked: [v5.3.1]
+  - client: geth
+    minimum: v1.14.0
+`), 0o600))
+
+	policy, err := LoadPolicy(policyFile)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name, raw, network string
+		expected           Status
+	}{
+		{name: "Compliant", raw: "Lighthouse/v5.3.1-d6ba8c3/x86_64-linux", network: "mainnet", expected: StatusCompliant},
+		{name: "Outdated", raw: "Lighthouse/v5.2.0/x86_64-linux", network: "mainnet", expected: StatusOutdated},
+		{name: "Blocked on network", raw: "Lighthouse/v5.3.1/x86_64-linux", network: "holesky", expected: StatusBlocked},
+		{name: "Execution client outdated", raw: "Geth/v1.13.15-stable/linux-amd64/go1.22", network: "mainnet", expected: StatusOutdated},
+		{name: "No rules for client", raw: "teku/v24.10.0/linux-x86_64", network: "mainnet", expected: StatusCompliant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := Parse(tt.raw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, policy.Check(info, tt.network))
+		})
+	}
+}
+
+func TestLoadPolicy_InvalidRule(t *testing.T) {
+	policyFile := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(policyFile, []byte(`
+rules:
+  - client: unknown
+    minimum: latest
+`), 0o600))
+
+	_, err := LoadPolicy(policyFile)
+	assert.ErrorContains(t, err, "unknown client")
+	assert.ErrorContains(t, err, "invalid semantic version")
+}